@@ -6,7 +6,10 @@ resource "uptimekuma_monitor" "http_example" {
   # Name: Display name for the monitor (string, required)
   name = "Example Website"
 
-  # Type: Monitor type - "http", "ping", "port", "keyword" (string, required)
+  # Description: Free text shown in the UI, e.g. a runbook link (string, optional)
+  description = "Runbook: https://wiki.example.com/runbooks/example-website"
+
+  # Type: Monitor type - "http", "ping", "port", "keyword", "mqtt", "grpc-keyword", "real-browser", "kafka-producer", "radius", "gamedig", "steam", "snmp", "rabbitmq", "tailscale-ping", "manual", or any other Uptime Kuma type together with raw_config (string, required)
   type = "http"
 
   # URL: Target URL to monitor (string, required for http/keyword monitors)
@@ -40,6 +43,18 @@ resource "uptimekuma_monitor" "ping_example" {
   # Hostname: Target hostname or IP address (string, required for ping/port monitors)
   hostname = "example.com"
 
+  # Ping Count: Packets sent per check (number, 1-100, default: 1)
+  ping_count = 3
+
+  # Ping Numeric: Skip reverse DNS lookups (boolean, default: true)
+  ping_numeric = true
+
+  # Ping Per Request Timeout: Timeout of each packet in seconds (number, 1-60, default: 2)
+  ping_per_request_timeout = 2
+
+  # Timeout: Overall timeout of the check in seconds (number, 1-300, default: 10)
+  timeout = 10
+
   interval       = 120
   retry_interval = 30
   max_retries    = 2
@@ -81,6 +96,208 @@ resource "uptimekuma_monitor" "port_example" {
   max_retries    = 1
 }
 
+# MQTT Monitor Example
+resource "uptimekuma_monitor" "mqtt_example" {
+  name     = "Clinic Gateway"
+  type     = "mqtt"
+  hostname = "mqtt.example.com"
+  port     = 1883
+
+  # MQTT Topic: Topic the monitor subscribes to (string, required for mqtt monitors)
+  mqtt_topic = "clinic/gateway/health"
+
+  # MQTT Username / Password: Broker credentials (string, optional; password is sensitive)
+  mqtt_username = "kuma"
+  mqtt_password = "securepassword"
+
+  # MQTT Check Type: "keyword" or "json-query" (string, default: "keyword")
+  mqtt_check_type = "keyword"
+
+  # MQTT Success Message: Expected message content (string, optional)
+  mqtt_success_message = "online"
+
+  interval = 60
+}
+
+# gRPC Keyword Monitor Example
+resource "uptimekuma_monitor" "grpc_example" {
+  name = "Patient Service gRPC"
+  type = "grpc-keyword"
+
+  # URL: gRPC server address as host:port (string, required for grpc-keyword monitors)
+  url = "patients.internal.example.com:50051"
+
+  # gRPC Protobuf: Service definition, usually read from a file (string, optional)
+  # Whitespace-only differences (indentation, trailing newline) do not cause drift
+  grpc_protobuf = file("${path.module}/health.proto")
+
+  # gRPC Service Name / Method: Fully qualified service and method to call (string)
+  grpc_service_name = "grpc.health.v1.Health"
+  grpc_method       = "Check"
+
+  # gRPC Body: JSON request body (string, optional)
+  grpc_body = "{}"
+
+  # gRPC Enable TLS: Connect using TLS (boolean, default: false)
+  grpc_enable_tls = true
+
+  # Keyword: Text expected in the response (string)
+  keyword = "SERVING"
+
+  # Invert Keyword: Alert when the keyword IS found (boolean, default: false)
+  invert_keyword = false
+
+  interval = 60
+}
+
+# Kafka Producer Monitor Example
+resource "uptimekuma_monitor" "kafka_example" {
+  name = "Event Pipeline"
+  type = "kafka-producer"
+
+  # Kafka Producer Brokers: Broker addresses as host:port (list of strings, required)
+  # Validated at plan time
+  kafka_producer_brokers = ["kafka-1.example.com:9092", "kafka-2.example.com:9092"]
+
+  # Kafka Producer Topic / Message: Where and what to produce (string)
+  kafka_producer_topic   = "health-checks"
+  kafka_producer_message = "ping"
+
+  # Kafka Producer SSL: Connect using SSL (boolean, default: false)
+  kafka_producer_ssl = true
+
+  # Kafka Producer SASL Options: Authentication (object, optional)
+  # mechanism: "plain", "scram-sha-256", "scram-sha-512" or "aws"
+  kafka_producer_sasl_options = {
+    mechanism = "scram-sha-512"
+    username  = "kuma"
+    password  = "securepassword"
+  }
+
+  interval = 60
+}
+
+# RADIUS Monitor Example
+resource "uptimekuma_monitor" "radius_example" {
+  name     = "Wi-Fi Authentication"
+  type     = "radius"
+  hostname = "radius.example.com"
+
+  # Port: RADIUS authentication port (number, default: 1812)
+  port = 1812
+
+  # RADIUS Username / Password: Test login (string; password is sensitive)
+  radius_username = "kuma-probe"
+  radius_password = "securepassword"
+
+  # RADIUS Secret: Shared client secret (string, sensitive)
+  radius_secret = "shared-secret"
+
+  # RADIUS Called / Calling Station ID: Station identifiers sent with the request (string, optional)
+  radius_called_station_id  = "00-11-22-33-44-55:clinic-wifi"
+  radius_calling_station_id = "AA-BB-CC-DD-EE-FF"
+
+  interval = 120
+}
+
+# Game Server (GameDig) Monitor Example
+resource "uptimekuma_monitor" "gamedig_example" {
+  name     = "Community Minecraft Server"
+  type     = "gamedig"
+  hostname = "mc.example.com"
+  port     = 25565
+
+  # Game: GameDig game identifier (string, required for gamedig monitors)
+  # Validated against the GameDig game list shipped with the provider
+  game = "minecraft"
+
+  # GameDig Given Port Only: Only query the given port (boolean, default: true)
+  gamedig_given_port_only = true
+
+  interval = 120
+}
+
+# Steam Game Server Monitor Example
+# Requires a Steam API key in the Uptime Kuma settings; a warning is shown at plan time otherwise
+resource "uptimekuma_monitor" "steam_example" {
+  name     = "Community CS2 Server"
+  type     = "steam"
+  hostname = "cs.example.com"
+  port     = 27015
+  interval = 120
+}
+
+# SNMP Monitor Example
+# Alerts when interface 1 of a switch reports an operational status other than up (1)
+resource "uptimekuma_monitor" "snmp_example" {
+  name                  = "Core Switch Uplink"
+  type                  = "snmp"
+  hostname              = "switch.example.com"
+  snmp_version          = "v2c"
+  snmp_community_string = "public"
+  snmp_oid              = "1.3.6.1.2.1.2.2.1.8.1"
+  json_path_operator    = "=="
+  expected_value        = "1"
+  interval              = 60
+}
+
+# RabbitMQ Monitor Example
+resource "uptimekuma_monitor" "rabbitmq_example" {
+  name = "Message Broker Cluster"
+  type = "rabbitmq"
+  rabbitmq_nodes = [
+    "https://rabbitmq-1.example.com:15672",
+    "https://rabbitmq-2.example.com:15672",
+  ]
+  rabbitmq_username = "monitoring"
+  rabbitmq_password = "securepassword"
+  interval          = 60
+}
+
+# Tailscale Ping Monitor Example
+resource "uptimekuma_monitor" "tailscale_ping_example" {
+  name     = "Office NAS over Tailscale"
+  type     = "tailscale-ping"
+  hostname = "nas"
+  interval = 60
+}
+
+# Manual Monitor Example
+# Shows a third-party dependency on a status page; flip manual_status during incidents
+resource "uptimekuma_monitor" "manual_example" {
+  name          = "SMS Provider"
+  type          = "manual"
+  manual_status = "up"
+}
+
+# Generic Monitor Example
+# Monitor types without dedicated attributes pass their fields through raw_config
+resource "uptimekuma_monitor" "dns_example" {
+  name     = "DNS Resolution"
+  type     = "dns"
+  interval = 60
+  raw_config = jsonencode({
+    hostname           = "example.com"
+    port               = 53
+    dns_resolve_server = "1.1.1.1"
+    dns_resolve_type   = "A"
+  })
+
+  # Conditions: Groups of clauses the result must meet, for dns monitors
+  # (list, optional). Groups and clauses are joined with and_or ("and" or
+  # "or", default: "and"); dns monitors support the "record" variable with
+  # the ==, !=, contains, !contains, starts_with, !starts_with, ends_with and
+  # !ends_with operators
+  conditions = [
+    {
+      clauses = [
+        { variable = "record", operator = "starts_with", value = "93.184." },
+        { and_or = "or", variable = "record", operator = "==", value = "192.0.2.1" },
+      ]
+    },
+  ]
+}
+
 # Authenticated HTTP Monitor Example
 resource "uptimekuma_monitor" "authenticated_http" {
   name           = "Authenticated API"
@@ -92,7 +309,7 @@ resource "uptimekuma_monitor" "authenticated_http" {
   max_retries    = 3
 
   # Auth Method: Authentication type (string, optional)
-  # Valid values: "basic", "ntlm", "mtls"
+  # Valid values: "basic", "ntlm", "mtls", "oauth2-cc"
   auth_method = "basic"
 
   # Basic Auth User: Username for basic authentication (string, optional)
@@ -101,13 +318,61 @@ resource "uptimekuma_monitor" "authenticated_http" {
   # Basic Auth Pass: Password for basic authentication (string, sensitive, optional)
   basic_auth_pass = "securepassword"
 
-  # Headers: Custom HTTP headers as JSON string (string, optional)
-  # Format: {"Header-Name": "value", "Another-Header": "value"}
-  headers = "{\"X-API-Key\":\"myapikey\", \"Accept\":\"application/json\"}"
+  # Request Headers: Custom HTTP headers by name (map of strings, optional)
+  request_headers = {
+    Accept = "application/json"
+  }
+
+  # Sensitive Headers: Headers whose values are hidden in plans (map of strings, sensitive, optional)
+  sensitive_headers = {
+    "X-API-Key" = "myapikey"
+  }
+
+  # Alternatively, headers can be given as a JSON object (string, optional).
+  # Formatting and key order differences are ignored.
+  # headers = jsonencode({ Accept = "application/json" })
+
+  # Accepted Status Codes: HTTP codes considered successful (set of strings, optional)
+  # Single codes or ranges. Default: ["200-299"]
+  accepted_status_codes = ["200-299", "304"]
+}
+
+# HTTP Monitor with mTLS client certificate authentication
+resource "uptimekuma_monitor" "http_mtls" {
+  name        = "Internal API (mTLS)"
+  type        = "http"
+  url         = "https://internal.example.com/health"
+  auth_method = "mtls"
 
-  # Accepted Status Codes: HTTP codes considered successful (list of numbers, optional)
-  # Default: All 2xx codes. Example: [200, 201, 204]
-  accepted_status_codes = [200, 201]
+  # TLS Cert/Key: PEM encoded client certificate and private key (tls_key is sensitive)
+  tls_cert = file("${path.module}/client.crt")
+  tls_key  = file("${path.module}/client.key")
+
+  # TLS CA: CA certificate used to verify the server (string, optional)
+  tls_ca = file("${path.module}/ca.crt")
+}
+
+# HTTP Monitor with OAuth2 client credentials authentication
+resource "uptimekuma_monitor" "http_oauth2" {
+  name        = "Partner API (OAuth2)"
+  type        = "http"
+  url         = "https://partner.example.com/v1/status"
+  auth_method = "oauth2-cc"
+
+  oauth_client_id     = "uptime-kuma"
+  oauth_client_secret = var.partner_api_client_secret
+  oauth_token_url     = "https://auth.partner.example.com/oauth/token"
+
+  # OAuth Scopes: Space separated scopes (string, optional)
+  oauth_scopes = "status:read"
+
+  # OAuth Auth Method: "client_secret_basic" (default) or "client_secret_post"
+  oauth_auth_method = "client_secret_post"
+}
+
+variable "partner_api_client_secret" {
+  type      = string
+  sensitive = true
 }
 
 # Advanced HTTP Monitor with Body
@@ -124,6 +389,19 @@ resource "uptimekuma_monitor" "http_with_body" {
   # Headers: Set Content-Type for the body
   headers = "{\"Content-Type\":\"application/json\"}"
 
+  # HTTP Body Encoding: "json" (default), "xml" or "form" (string, optional)
+  http_body_encoding = "json"
+
+  # Timeout: Request timeout in seconds (number, optional)
+  # Default: 80% of the interval
+  timeout = 30
+
+  # Cache Bust: Add a random query parameter to bypass caches (bool, optional)
+  cache_bust = true
+
+  # Expiry Notification: Notify before the TLS certificate expires (bool, optional)
+  expiry_notification = true
+
   interval       = 300
   retry_interval = 60
   max_retries    = 2
@@ -153,8 +431,9 @@ resource "uptimekuma_monitor" "tagged_monitor" {
   retry_interval = 10
   max_retries    = 3
 
-  # Tags: Associate tags with the monitor (list of objects, optional)
-  # Each tag requires tag_id (required) and optional value
+  # Tags: Associate tags with the monitor (set of objects, optional)
+  # Each tag requires tag_id (required) and optional value; the same tag
+  # can be set several times with different values
   tags = [
     {
       # tag_id: Reference to the tag resource ID (number, required)
@@ -189,3 +468,24 @@ resource "uptimekuma_monitor" "multi_tagged_monitor" {
     }
   ]
 }
+
+# Monitor with Tags Referenced by Name
+# Tags that do not exist are created when the provider sets
+# auto_create_tags = true, and are an error otherwise
+resource "uptimekuma_monitor" "named_tags_monitor" {
+  name = "Payments API"
+  type = "http"
+  url  = "https://payments.example.com/health"
+
+  tags = [
+    {
+      name  = "env"
+      value = "prod"
+    },
+    {
+      name  = "team"
+      color = "#2563EB"
+      value = "payments"
+    }
+  ]
+}
@@ -0,0 +1,23 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+# Checks that example.com has an AAAA record on the given resolver
+resource "uptimekuma_monitor_dns" "ipv6" {
+  name               = "example.com IPv6"
+  hostname           = "example.com"
+  dns_resolve_type   = "AAAA"
+  dns_resolve_server = "8.8.8.8"
+  interval           = 300
+}
+
+# Checks that the A record of example.com points into the expected network
+resource "uptimekuma_monitor_dns" "address" {
+  name     = "example.com address"
+  hostname = "example.com"
+
+  conditions = [{
+    clauses = [
+      { variable = "record", operator = "starts_with", value = "93.184." },
+    ]
+  }]
+}
@@ -0,0 +1,31 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+# HTTP(s) monitor with only the attributes http monitors support
+resource "uptimekuma_monitor_http" "website" {
+  name = "Example Website"
+
+  # URL: Target URL to monitor (string, required)
+  url = "https://example.com"
+
+  # Method: HTTP method to use (string, default: "GET")
+  method = "GET"
+
+  # Accepted Status Codes: Status codes or ranges treated as UP (default: ["200-299"])
+  accepted_status_codes = ["200", "204"]
+
+  interval    = 60
+  max_retries = 3
+}
+
+# Authenticated API with a JSON body
+resource "uptimekuma_monitor_http" "api" {
+  name            = "Orders API"
+  url             = "https://api.example.com/orders/health"
+  method          = "POST"
+  body            = jsonencode({ probe = true })
+  headers         = jsonencode({ "Content-Type" = "application/json" })
+  auth_method     = "basic"
+  basic_auth_user = "monitor"
+  basic_auth_pass = "securepassword"
+}
@@ -0,0 +1,18 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+# Keyword monitor: DOWN when the page does not contain the keyword
+resource "uptimekuma_monitor_keyword" "homepage" {
+  name    = "Homepage Content"
+  url     = "https://example.com"
+  keyword = "Example Domain"
+}
+
+# Inverted keyword monitor: DOWN when the page contains the keyword
+resource "uptimekuma_monitor_keyword" "no_errors" {
+  name           = "Status Endpoint Errors"
+  url            = "https://example.com/status"
+  keyword        = "error"
+  invert_keyword = true
+  interval       = 120
+}
@@ -0,0 +1,8 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+resource "uptimekuma_monitor_ping" "gateway" {
+  name     = "Office Gateway"
+  hostname = "192.168.1.1"
+  interval = 30
+}
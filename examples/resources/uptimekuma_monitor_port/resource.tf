@@ -0,0 +1,9 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+resource "uptimekuma_monitor_port" "database" {
+  name     = "Database Server"
+  hostname = "db.example.com"
+  port     = 5432
+  interval = 60
+}
@@ -0,0 +1,38 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+variable "egress_proxy_password" {
+  type      = string
+  sensitive = true
+}
+
+# Proxies route the requests of http and keyword monitors, e.g. through an
+# egress proxy in front of a private network
+resource "uptimekuma_proxy" "egress" {
+  # Protocol: "http", "https", "socks4", "socks5" or "socks5h" (string, required)
+  protocol = "http"
+  host     = "egress.internal.example.com"
+  port     = 3128
+
+  # Auth: Whether the proxy requires credentials (bool, default: false)
+  # username and password are required when auth is true
+  auth     = true
+  username = "uptime-kuma"
+  password = var.egress_proxy_password
+
+  # Default: Preselect the proxy for new monitors in the UI (bool, default: false)
+  default = false
+
+  # Apply Existing: Route all existing monitors through the proxy when it is
+  # created or updated (bool, default: false)
+  apply_existing = false
+}
+
+# HTTP monitor using the proxy
+resource "uptimekuma_monitor" "intranet" {
+  name        = "Intranet"
+  type        = "http"
+  url         = "https://intranet.example.com"
+  description = "Runbook: https://wiki.example.com/runbooks/intranet"
+  proxy_id    = uptimekuma_proxy.egress.id
+}
@@ -0,0 +1,24 @@
+# Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+# SPDX-License-Identifier: MPL-2.0
+
+# Remote browsers let real-browser monitors run in a Chromium instance outside
+# the Uptime Kuma container (e.g., browserless/chrome)
+
+resource "uptimekuma_remote_browser" "chrome" {
+  # Name: Display name for the remote browser (string, required)
+  name = "browserless"
+
+  # URL: WebSocket endpoint of the browser (string, sensitive, required)
+  # Tokens embedded in the URL are kept out of plan output
+  url = "ws://chrome.internal.example.com:3000/chrome/playwright?token=secret"
+}
+
+# Real-browser monitor using the remote browser
+resource "uptimekuma_monitor" "spa" {
+  name              = "Patient Portal"
+  type              = "real-browser"
+  url               = "https://portal.example.com"
+  remote_browser_id = uptimekuma_remote_browser.chrome.id
+  keyword           = "Sign in"
+  interval          = 300
+}
@@ -26,6 +26,36 @@ type Config struct {
 type Client struct {
 	Kuma *kuma.Client
 	// Mutex is handled internally by the library
+
+	// AutoCreateTags creates the tags monitors reference by name when they
+	// do not exist. Set from the provider configuration.
+	AutoCreateTags bool
+	// DefaultTags are added to every monitor. Set from the provider configuration.
+	DefaultTags []MonitorTag
+	// MonitorDefaults are used for the attributes a monitor does not set.
+	// Set from the provider configuration.
+	MonitorDefaults MonitorDefaults
+}
+
+// MonitorDefaults holds defaults for monitor attributes. Nil fields have no
+// default, in which case the schema default applies.
+type MonitorDefaults struct {
+	Interval            *int64
+	RetryInterval       *int64
+	ResendInterval      *int64
+	MaxRetries          *int64
+	UpsideDown          *bool
+	NotificationIDs     []int64
+	AcceptedStatusCodes []string
+}
+
+// MonitorTag is a tag of a monitor, referenced by TagID, or by Name when
+// TagID is zero. Color is used when a tag referenced by name is created.
+type MonitorTag struct {
+	TagID int64
+	Name  string
+	Color string
+	Value string
 }
 
 // New creates a new Uptime Kuma API client.
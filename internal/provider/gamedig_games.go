@@ -0,0 +1,275 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// gamedigGames lists the game identifiers understood by GameDig 4, the library
+// Uptime Kuma uses for gamedig monitors. It is used to validate the game
+// attribute at plan time. Keep it in sync with the GameDig version bundled with
+// the supported Uptime Kuma release.
+var gamedigGames = []string{
+	"7d2d",
+	"ageofchivalry",
+	"aoe2",
+	"alienarena",
+	"alienswarm",
+	"arkse",
+	"arma2",
+	"arma2oa",
+	"arma3",
+	"armagetron",
+	"armareforger",
+	"assettocorsa",
+	"atlas",
+	"avorion",
+	"baldursgate",
+	"ballisticoverkill",
+	"barotrauma",
+	"bat1944",
+	"bd",
+	"bf1942",
+	"bf2",
+	"bf2142",
+	"bf3",
+	"bf4",
+	"bfbc2",
+	"bfh",
+	"bfv",
+	"bfvietnam",
+	"breach",
+	"breed",
+	"brink",
+	"buildandshoot",
+	"cod",
+	"cod2",
+	"cod3",
+	"cod4",
+	"codmw2",
+	"codmw3",
+	"coduo",
+	"codwaw",
+	"colonysurvival",
+	"conanexiles",
+	"contagion",
+	"contractjack",
+	"corekeeper",
+	"crce",
+	"crysis",
+	"crysis2",
+	"crysiswars",
+	"cs15",
+	"cs16",
+	"cs2d",
+	"cscz",
+	"csgo",
+	"css",
+	"dab",
+	"daikatana",
+	"dayz",
+	"dayzmod",
+	"ddd",
+	"dmomam",
+	"dnl",
+	"dod",
+	"dods",
+	"doi",
+	"doom3",
+	"dota2",
+	"dst",
+	"eco",
+	"empiresmod",
+	"etqw",
+	"ffe",
+	"ffow",
+	"fivem",
+	"fof",
+	"garrysmod",
+	"geneshift",
+	"giantscitizenkabuto",
+	"globaloperations",
+	"ges",
+	"gore",
+	"gta5f",
+	"gtasam",
+	"gtasamta",
+	"gtasao",
+	"gtavcmta",
+	"had2",
+	"halo",
+	"halo2",
+	"heretic2",
+	"hexen2",
+	"hidden",
+	"hl2dm",
+	"hldm",
+	"hldms",
+	"homefront",
+	"hurtworld",
+	"igi2",
+	"il2",
+	"insurgency",
+	"insurgencymic",
+	"insurgencysandstorm",
+	"ironsight",
+	"jb0n",
+	"jc2mp",
+	"jc3mp",
+	"kartkrash",
+	"killingfloor",
+	"killingfloor2",
+	"kingpin",
+	"kisspc",
+	"kspdmp",
+	"l4d",
+	"l4d2",
+	"m2mp",
+	"m2o",
+	"medievalengineers",
+	"minecraft",
+	"minecraftbe",
+	"mnc",
+	"moh",
+	"mohaa",
+	"mohbt",
+	"mohpa",
+	"mohsh",
+	"mordhau",
+	"mtasa",
+	"mtavc",
+	"mumble",
+	"mumbleping",
+	"mutantfactions",
+	"nascarthunder2004",
+	"netpanzer",
+	"nmrih",
+	"ns",
+	"ns2",
+	"nfshp2",
+	"nab",
+	"openttd",
+	"operationflashpoint",
+	"painkiller",
+	"pixark",
+	"postal2",
+	"postscriptum",
+	"prbf2",
+	"prey",
+	"projectcars",
+	"projectcars2",
+	"przomboid",
+	"quake1",
+	"quake2",
+	"quake3",
+	"quakelive",
+	"r6",
+	"r6roguespear",
+	"r6ravenshield",
+	"rallisportchallenge",
+	"rallymasters",
+	"redm",
+	"redorchestra",
+	"redorchestra2",
+	"redorchestraost",
+	"rfactor",
+	"ricochet",
+	"risingworld",
+	"ror2",
+	"rune",
+	"rust",
+	"samp",
+	"savage2",
+	"serioussam",
+	"serioussam2",
+	"shatteredhorizon",
+	"ship",
+	"sin",
+	"sof",
+	"sof2",
+	"soldat",
+	"sotf",
+	"spaceengineers",
+	"squad",
+	"stalker",
+	"starbound",
+	"starmade",
+	"starsiege",
+	"swat4",
+	"sven",
+	"swbf",
+	"swbf2",
+	"swjk",
+	"swjk2",
+	"swrc",
+	"teamfactor",
+	"teamspeak2",
+	"teamspeak3",
+	"terraria",
+	"tf2",
+	"tfc",
+	"theforest",
+	"thefront",
+	"theisle",
+	"thps3",
+	"thps4",
+	"thu2",
+	"tie",
+	"toh",
+	"tremulous",
+	"trackmania2",
+	"trackmaniaforever",
+	"turok2",
+	"unreal",
+	"unturned",
+	"urbanterror",
+	"ut",
+	"ut2004",
+	"ut3",
+	"v8supercar",
+	"valheim",
+	"vcmp",
+	"ventrilo",
+	"vietcong",
+	"vietcong2",
+	"vrising",
+	"warsow",
+	"wheeloftime",
+	"wolfenstein",
+	"wolfenstein2009",
+	"wolfensteinet",
+	"wurm",
+	"zps",
+}
+
+// gamedigGameValidator checks that a value is one of gamedigGames. The list
+// is too long for an error message, so the error points to the documentation.
+type gamedigGameValidator struct{}
+
+func (v gamedigGameValidator) Description(ctx context.Context) string {
+	return "must be a GameDig game identifier"
+}
+
+func (v gamedigGameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gamedigGameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if game := req.ConfigValue.ValueString(); !slices.Contains(gamedigGames, game) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%q is not a GameDig game identifier. The supported identifiers are listed in the \"GameDig Games\" section of the uptimekuma_monitor documentation.", game),
+		)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the custom type satisfies framework interfaces.
+var _ basetypes.StringTypable = JSONObjectStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = JSONObjectString{}
+var _ xattr.ValidateableAttribute = JSONObjectString{}
+
+// JSONObjectStringType is a string type holding a JSON object. Values are
+// considered equal when they decode to the same object, so formatting and key
+// order differences between the configuration (jsonencode, heredocs) and the
+// JSON returned by Uptime Kuma do not show up as drift.
+type JSONObjectStringType struct {
+	basetypes.StringType
+}
+
+func (t JSONObjectStringType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONObjectStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t JSONObjectStringType) String() string {
+	return "JSONObjectStringType"
+}
+
+func (t JSONObjectStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONObjectString{StringValue: in}, nil
+}
+
+func (t JSONObjectStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t JSONObjectStringType) ValueType(ctx context.Context) attr.Value {
+	return JSONObjectString{}
+}
+
+// JSONObjectString is the value type of JSONObjectStringType.
+type JSONObjectString struct {
+	basetypes.StringValue
+}
+
+// NewJSONObjectStringValue returns a known JSONObjectString.
+func NewJSONObjectStringValue(value string) JSONObjectString {
+	return JSONObjectString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewJSONObjectStringNull returns a null JSONObjectString.
+func NewJSONObjectStringNull() JSONObjectString {
+	return JSONObjectString{StringValue: basetypes.NewStringNull()}
+}
+
+func (v JSONObjectString) Equal(o attr.Value) bool {
+	other, ok := o.(JSONObjectString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONObjectString) Type(ctx context.Context) attr.Type {
+	return JSONObjectStringType{}
+}
+
+// ValidateAttribute ensures known values decode to a JSON object.
+func (v JSONObjectString) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := v.Object(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("The value must be a JSON object: %s", err),
+		)
+	}
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON object.
+func (v JSONObjectString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONObjectString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	current, err := normalizeJSONObject(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	proposed, err := normalizeJSONObject(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return current == proposed, diags
+}
+
+// Object decodes the value into a map.
+func (v JSONObjectString) Object() (map[string]any, error) {
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(v.ValueString()), &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("got null instead of an object")
+	}
+
+	return obj, nil
+}
+
+// normalizeJSONObject re-encodes a JSON object compactly with sorted keys.
+func normalizeJSONObject(s string) (string, error) {
+	obj, err := NewJSONObjectStringValue(s).Object()
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestJSONObjectStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		current  string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			current:  `{"a":1}`,
+			new:      `{"a":1}`,
+			expected: true,
+		},
+		"formatting": {
+			current:  "{\n  \"a\": 1,\n  \"b\": [true, null]\n}\n",
+			new:      `{"a":1,"b":[true,null]}`,
+			expected: true,
+		},
+		"key order": {
+			current:  `{"a":"x","b":"y"}`,
+			new:      `{"b":"y","a":"x"}`,
+			expected: true,
+		},
+		"different value": {
+			current:  `{"a":1}`,
+			new:      `{"a":2}`,
+			expected: false,
+		},
+		"different type": {
+			current:  `{"a":1}`,
+			new:      `{"a":"1"}`,
+			expected: false,
+		},
+		"invalid json": {
+			current:  `{"a":1}`,
+			new:      `{"a":`,
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := NewJSONObjectStringValue(tc.current).StringSemanticEquals(
+				context.Background(),
+				NewJSONObjectStringValue(tc.new),
+			)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestJSONObjectStringValidateAttribute(t *testing.T) {
+	tests := map[string]struct {
+		value     JSONObjectString
+		expectErr bool
+	}{
+		"object": {
+			value: NewJSONObjectStringValue(`{"a":1}`),
+		},
+		"null": {
+			value: NewJSONObjectStringNull(),
+		},
+		"array": {
+			value:     NewJSONObjectStringValue(`[1,2]`),
+			expectErr: true,
+		},
+		"json null": {
+			value:     NewJSONObjectStringValue(`null`),
+			expectErr: true,
+		},
+		"invalid": {
+			value:     NewJSONObjectStringValue(`{a:1}`),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tc.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("raw_config")}, resp)
+			if resp.Diagnostics.HasError() != tc.expectErr {
+				t.Errorf("expected error %t, got %v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,762 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
+)
+
+// Bounds of the check interval enforced by Uptime Kuma, in seconds.
+const (
+	minMonitorInterval = 20
+	maxMonitorInterval = 24 * 24 * 60 * 60
+)
+
+// MonitorBaseModel describes the attributes shared by every monitor resource.
+// It is embedded in the data model of each monitor resource.
+type MonitorBaseModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Active             types.Bool   `tfsdk:"active"`
+	Interval           types.Int64  `tfsdk:"interval"`
+	RetryInterval      types.Int64  `tfsdk:"retry_interval"`
+	ResendInterval     types.Int64  `tfsdk:"resend_interval"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	UpsideDown         types.Bool   `tfsdk:"upside_down"`
+	NotificationIDList types.List   `tfsdk:"notification_id_list"`
+	Tags               types.Set    `tfsdk:"tags"`
+	TagsAll            types.Set    `tfsdk:"tags_all"`
+}
+
+// monitorTagModel describes an entry of the tags attribute.
+type monitorTagModel struct {
+	TagID types.Int64  `tfsdk:"tag_id"`
+	Name  types.String `tfsdk:"name"`
+	Color types.String `tfsdk:"color"`
+	Value types.String `tfsdk:"value"`
+}
+
+func monitorTagAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tag_id": types.Int64Type,
+		"name":   types.StringType,
+		"color":  types.StringType,
+		"value":  types.StringType,
+	}
+}
+
+// unresolved reports whether the tag is referenced by a known name and its ID
+// has not been looked up yet.
+func (t monitorTagModel) unresolved() bool {
+	return !t.Name.IsNull() && !t.Name.IsUnknown() && (t.TagID.IsNull() || t.TagID.IsUnknown())
+}
+
+// defaultMonitorTagColor is the color of tags created for monitor tags
+// referenced by name without a color.
+const defaultMonitorTagColor = "#4B5563"
+
+// withMonitorBaseAttributes adds the schema attributes shared by every monitor
+// resource to the type-specific attributes of a monitor resource.
+func withMonitorBaseAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	maps.Copy(attributes, monitorBaseSchemaAttributes())
+	return attributes
+}
+
+// monitorBaseSchemaAttributes returns the schema attributes shared by every monitor resource.
+func monitorBaseSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Monitor identifier",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Monitor name",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Monitor description, e.g. a runbook link",
+			Optional:            true,
+		},
+		"active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the monitor is active (enabled). Defaults to true.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"interval": schema.Int64Attribute{
+			MarkdownDescription: "Check interval in seconds (20 to 2073600, i.e. 24 days)",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(60),
+			Validators: []validator.Int64{
+				int64validator.Between(minMonitorInterval, maxMonitorInterval),
+			},
+		},
+		"retry_interval": schema.Int64Attribute{
+			MarkdownDescription: "Retry interval in seconds",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(60),
+		},
+		"resend_interval": schema.Int64Attribute{
+			MarkdownDescription: "Notification resend interval in seconds",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+		},
+		"max_retries": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of retries",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+		},
+		"upside_down": schema.BoolAttribute{
+			MarkdownDescription: "Invert status (treat DOWN as UP and vice versa)",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"notification_id_list": schema.ListAttribute{
+			ElementType:         types.Int64Type,
+			MarkdownDescription: "List of notification IDs to trigger when monitor status changes",
+			Optional:            true,
+			Computed:            true,
+		},
+		"tags": schema.SetNestedAttribute{
+			MarkdownDescription: "Tags associated with the monitor. The same tag can be set several times with different values.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tag_id": schema.Int64Attribute{
+						MarkdownDescription: "Tag ID. Either `tag_id` or `name` must be set.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("name")),
+						},
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Tag name, resolved to the tag ID. A tag that does not exist when applying is an error, unless the provider sets `auto_create_tags`.",
+						Optional:            true,
+					},
+					"color": schema.StringAttribute{
+						MarkdownDescription: "Color of the tag created for `name` when it does not exist, in hex format. Defaults to " + defaultMonitorTagColor + ". Existing tags are not changed.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^#([0-9A-Fa-f]{3}){1,2}$`),
+								"must be a valid hex color code (e.g., #FFF or #FFFFFF)",
+							),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("name")),
+						},
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "Value for the tag",
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"tags_all": schema.SetNestedAttribute{
+			MarkdownDescription: "All tags of the monitor: `tags` merged with the `default_tags` of the provider. A default tag is left out when `tags` sets the same tag.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tag_id": schema.Int64Attribute{
+						MarkdownDescription: "Tag ID",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Tag name, when the tag is referenced by name",
+						Computed:            true,
+					},
+					"color": schema.StringAttribute{
+						MarkdownDescription: "Color of the tag created for `name` when it does not exist",
+						Computed:            true,
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "Value for the tag",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// kumaBase converts the shared attributes into the library base monitor.
+func (m MonitorBaseModel) kumaBase(ctx context.Context) kumamonitor.Base {
+	base := kumamonitor.Base{
+		ID:             m.ID.ValueInt64(),
+		Name:           m.Name.ValueString(),
+		IsActive:       m.Active.ValueBool(),
+		Interval:       m.Interval.ValueInt64(),
+		RetryInterval:  m.RetryInterval.ValueInt64(),
+		ResendInterval: m.ResendInterval.ValueInt64(),
+		MaxRetries:     m.MaxRetries.ValueInt64(),
+		UpsideDown:     m.UpsideDown.ValueBool(),
+		Description:    m.Description.ValueStringPointer(),
+	}
+
+	// Notification IDs
+	if !m.NotificationIDList.IsNull() && !m.NotificationIDList.IsUnknown() {
+		var notifIDs []int64
+		m.NotificationIDList.ElementsAs(ctx, &notifIDs, false)
+		base.NotificationIDs = notifIDs
+	}
+
+	// Tags, including the default tags of the provider
+	for _, t := range monitorTagPairs(ctx, m.TagsAll) {
+		base.Tags = append(base.Tags, tag.MonitorTag{TagID: t.tagID, Value: t.value})
+	}
+
+	return base
+}
+
+// setFromKuma updates the shared attributes from the library base monitor.
+func (m *MonitorBaseModel) setFromKuma(ctx context.Context, b kumamonitor.Base) {
+	m.ID = types.Int64Value(b.ID)
+	m.Name = types.StringValue(b.Name)
+	m.Active = types.BoolValue(b.IsActive)
+	m.Interval = types.Int64Value(b.Interval)
+	m.RetryInterval = types.Int64Value(b.RetryInterval)
+	m.ResendInterval = types.Int64Value(b.ResendInterval)
+	m.MaxRetries = types.Int64Value(b.MaxRetries)
+	m.UpsideDown = types.BoolValue(b.UpsideDown)
+	m.Description = stringPointerValueOrNull(b.Description)
+
+	if len(b.NotificationIDs) > 0 {
+		m.NotificationIDList, _ = types.ListValueFrom(ctx, types.Int64Type, b.NotificationIDs)
+	} else {
+		m.NotificationIDList = types.ListNull(types.Int64Type)
+	}
+
+	m.setTagsFromKuma(ctx, b.Tags)
+}
+
+// setTagsFromKuma updates tags and tags_all from the tags returned by Uptime
+// Kuma. Tags added by the provider default_tags are only kept in tags_all:
+// tags keeps the tags it already had and any tag not known from tags_all, so
+// that tags added outside of Terraform show as drift.
+func (m *MonitorBaseModel) setTagsFromKuma(ctx context.Context, tags []tag.MonitorTag) {
+	inTags := make(map[monitorTagPair]bool)
+	for _, t := range monitorTagPairs(ctx, m.Tags) {
+		inTags[t] = true
+	}
+	inTagsAll := make(map[monitorTagPair]bool)
+	for _, t := range monitorTagPairs(ctx, m.TagsAll) {
+		inTagsAll[t] = true
+	}
+
+	var configured []tag.MonitorTag
+	for _, t := range tags {
+		pair := monitorTagPair{tagID: t.TagID, value: t.Value}
+		if inTags[pair] || !inTagsAll[pair] {
+			configured = append(configured, t)
+		}
+	}
+
+	m.TagsAll = monitorTagsValue(ctx, tags, m.TagsAll, m.Tags)
+	m.Tags = monitorTagsValue(ctx, configured, m.Tags)
+}
+
+// stringPointerValueOrNull maps missing and empty strings returned by Uptime
+// Kuma to null.
+func stringPointerValueOrNull(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return stringValueOrNull(*s)
+}
+
+// monitorTagPair is a tag of a monitor. A monitor can have the same tag
+// several times with different values, so tags are identified by the pair.
+type monitorTagPair struct {
+	tagID int64
+	value string
+}
+
+// monitorTagsValue converts monitor tags returned by Uptime Kuma into the
+// tags attribute value. The name and color of tags referenced by name are
+// kept from the prior values, so that they match the configuration.
+func monitorTagsValue(ctx context.Context, tags []tag.MonitorTag, priors ...types.Set) types.Set {
+	objType := types.ObjectType{AttrTypes: monitorTagAttrTypes()}
+	if len(tags) == 0 {
+		return types.SetNull(objType)
+	}
+
+	byName := make(map[monitorTagPair]monitorTagModel)
+	for _, prior := range priors {
+		if prior.IsNull() || prior.IsUnknown() {
+			continue
+		}
+		var priorTags []monitorTagModel
+		prior.ElementsAs(ctx, &priorTags, false)
+		for _, t := range priorTags {
+			pair := monitorTagPair{tagID: t.TagID.ValueInt64(), value: t.Value.ValueString()}
+			if _, ok := byName[pair]; !ok && !t.Name.IsNull() {
+				byName[pair] = t
+			}
+		}
+	}
+
+	tfTags := make([]monitorTagModel, 0, len(tags))
+	seen := make(map[monitorTagPair]bool, len(tags))
+	for _, t := range tags {
+		pair := monitorTagPair{tagID: t.TagID, value: t.Value}
+		if seen[pair] {
+			continue
+		}
+		seen[pair] = true
+
+		tm := monitorTagModel{
+			TagID: types.Int64Value(t.TagID),
+			Name:  types.StringNull(),
+			Color: types.StringNull(),
+			Value: types.StringNull(),
+		}
+		if named, ok := byName[pair]; ok {
+			tm.Name = named.Name
+			tm.Color = named.Color
+		}
+		if t.Value != "" {
+			tm.Value = types.StringValue(t.Value)
+		}
+		tfTags = append(tfTags, tm)
+	}
+
+	set, _ := types.SetValueFrom(ctx, objType, tfTags)
+	return set
+}
+
+// monitorTagPairs returns the distinct tag and value pairs of the tags attribute.
+func monitorTagPairs(ctx context.Context, tags types.Set) []monitorTagPair {
+	if tags.IsNull() || tags.IsUnknown() {
+		return nil
+	}
+
+	var tfTags []monitorTagModel
+	tags.ElementsAs(ctx, &tfTags, false)
+
+	pairs := make([]monitorTagPair, 0, len(tfTags))
+	seen := make(map[monitorTagPair]bool, len(tfTags))
+	for _, t := range tfTags {
+		// A null value and an empty value are the same tag in Uptime Kuma
+		pair := monitorTagPair{tagID: t.TagID.ValueInt64(), value: t.Value.ValueString()}
+		if !seen[pair] {
+			seen[pair] = true
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+// createMonitorTags adds the planned tags to a newly created monitor. Tags
+// are managed separately from the monitor via the AddMonitorTag API.
+func createMonitorTags(ctx context.Context, c *client.Client, monitorID int64, tags types.Set) error {
+	for _, t := range monitorTagPairs(ctx, tags) {
+		if _, err := c.Kuma.AddMonitorTag(ctx, t.tagID, monitorID, t.value); err != nil {
+			return fmt.Errorf("unable to add tag %d to monitor %d: %w", t.tagID, monitorID, err)
+		}
+	}
+
+	return nil
+}
+
+// updateMonitorTags reconciles the tags of a monitor with the plan. The
+// changes are computed against the tags stored in Uptime Kuma rather than the
+// prior state, so that applying again after a partial failure neither removes
+// a missing tag nor adds a tag twice. Removals run first, so that a value
+// moved between tags never exists twice.
+func updateMonitorTags(ctx context.Context, c *client.Client, monitorID int64, planTags types.Set) error {
+	current, err := c.Kuma.GetMonitor(ctx, monitorID)
+	if err != nil {
+		return fmt.Errorf("unable to read the tags of monitor %d: %w", monitorID, err)
+	}
+
+	stored := make(map[monitorTagPair]bool, len(current.Tags))
+	for _, t := range current.Tags {
+		stored[monitorTagPair{tagID: t.TagID, value: t.Value}] = true
+	}
+	planned := make(map[monitorTagPair]bool)
+	for _, t := range monitorTagPairs(ctx, planTags) {
+		planned[t] = true
+	}
+
+	// Remove tags that are stored but not planned
+	for t := range stored {
+		if planned[t] {
+			continue
+		}
+		if err := c.Kuma.DeleteMonitorTagWithValue(ctx, t.tagID, monitorID, t.value); err != nil {
+			return fmt.Errorf("unable to remove tag %d from monitor %d: %w", t.tagID, monitorID, err)
+		}
+	}
+
+	// Add tags that are planned but not stored
+	for t := range planned {
+		if stored[t] {
+			continue
+		}
+		if _, err := c.Kuma.AddMonitorTag(ctx, t.tagID, monitorID, t.value); err != nil {
+			return fmt.Errorf("unable to add tag %d to monitor %d: %w", t.tagID, monitorID, err)
+		}
+	}
+
+	return nil
+}
+
+// resolveMonitorTags sets the ID of the tags referenced by name. When
+// planning, the ID of a tag that does not exist is unknown, as it may be
+// created by another resource. When applying, a tag that still does not
+// exist is created if the provider sets auto_create_tags, and is an error
+// otherwise.
+func resolveMonitorTags(ctx context.Context, c *client.Client, tags types.Set, apply bool) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if tags.IsNull() || tags.IsUnknown() {
+		return tags, diags
+	}
+
+	var tfTags []monitorTagModel
+	diags.Append(tags.ElementsAs(ctx, &tfTags, false)...)
+	if diags.HasError() {
+		return tags, diags
+	}
+
+	// Only list the tags when a tag is referenced by name
+	unresolved := false
+	for _, t := range tfTags {
+		unresolved = unresolved || t.unresolved()
+	}
+	if !unresolved {
+		return tags, diags
+	}
+
+	existing, err := c.Kuma.GetTags(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read tags: %s", err))
+		return tags, diags
+	}
+	ids := make(map[string]int64, len(existing))
+	for _, t := range existing {
+		ids[t.Name] = t.ID
+	}
+
+	for i, t := range tfTags {
+		if !t.unresolved() {
+			continue
+		}
+
+		name := t.Name.ValueString()
+		if id, ok := ids[name]; ok {
+			tfTags[i].TagID = types.Int64Value(id)
+			continue
+		}
+
+		switch {
+		case !apply:
+			// The tag may be created by another resource in the same apply
+			tfTags[i].TagID = types.Int64Unknown()
+		case !c.AutoCreateTags:
+			diags.AddAttributeError(
+				path.Root("tags"),
+				"Tag Not Found",
+				fmt.Sprintf("No tag named %q exists. Create it with an uptimekuma_tag resource, or set auto_create_tags in the provider configuration.", name),
+			)
+		default:
+			color := defaultMonitorTagColor
+			if !t.Color.IsNull() {
+				color = t.Color.ValueString()
+			}
+			id, err := c.Kuma.CreateTag(ctx, tag.Tag{Name: name, Color: color})
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to create tag %q: %s", name, err))
+				return tags, diags
+			}
+			ids[name] = id
+			tfTags[i].TagID = types.Int64Value(id)
+		}
+	}
+	if diags.HasError() {
+		return tags, diags
+	}
+
+	resolved, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: monitorTagAttrTypes()}, tfTags)
+	diags.Append(d...)
+	return resolved, diags
+}
+
+// planMonitorTags sets the planned ID of the tags referenced by name, and
+// plans tags_all as tags merged with the default tags of the provider.
+func planMonitorTags(ctx context.Context, c *client.Client, plan *tfsdk.Plan) diag.Diagnostics {
+	var tags types.Set
+	diags := plan.GetAttribute(ctx, path.Root("tags"), &tags)
+	if diags.HasError() {
+		return diags
+	}
+
+	tags, d := resolveMonitorTags(ctx, c, tags, false)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("tags"), tags)...)
+
+	defaults, d := resolveMonitorTags(ctx, c, defaultMonitorTagsValue(ctx, c.DefaultTags), false)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("tags_all"), mergeMonitorTags(ctx, tags, defaults))...)
+	return diags
+}
+
+// resolvePlannedMonitorTags resolves the tags referenced by name in tags and
+// tags_all when applying, creating the missing ones if allowed.
+func resolvePlannedMonitorTags(ctx context.Context, c *client.Client, m *MonitorBaseModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	m.Tags, d = resolveMonitorTags(ctx, c, m.Tags, true)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	m.TagsAll, d = resolveMonitorTags(ctx, c, m.TagsAll, true)
+	diags.Append(d...)
+	return diags
+}
+
+// defaultMonitorTagsValue converts the default tags of the provider into a
+// tags attribute value.
+func defaultMonitorTagsValue(ctx context.Context, defaults []client.MonitorTag) types.Set {
+	objType := types.ObjectType{AttrTypes: monitorTagAttrTypes()}
+	if len(defaults) == 0 {
+		return types.SetNull(objType)
+	}
+
+	tfTags := make([]monitorTagModel, 0, len(defaults))
+	for _, d := range defaults {
+		tm := monitorTagModel{
+			TagID: types.Int64Null(),
+			Name:  types.StringNull(),
+			Color: types.StringNull(),
+			Value: types.StringNull(),
+		}
+		if d.TagID != 0 {
+			tm.TagID = types.Int64Value(d.TagID)
+		} else {
+			tm.Name = types.StringValue(d.Name)
+		}
+		if d.Color != "" {
+			tm.Color = types.StringValue(d.Color)
+		}
+		if d.Value != "" {
+			tm.Value = types.StringValue(d.Value)
+		}
+		tfTags = append(tfTags, tm)
+	}
+
+	set, _ := types.SetValueFrom(ctx, objType, tfTags)
+	return set
+}
+
+// mergeMonitorTags merges the default tags of the provider into tags. A
+// default tag is left out when tags sets the same tag, with the same ID or
+// name, so that a monitor can override the value of a default tag. Both are
+// expected to be resolved, so that a tag referenced by ID on one side and by
+// name on the other is matched by its ID.
+func mergeMonitorTags(ctx context.Context, tags, defaults types.Set) types.Set {
+	objType := types.ObjectType{AttrTypes: monitorTagAttrTypes()}
+	if tags.IsUnknown() || defaults.IsUnknown() {
+		return types.SetUnknown(objType)
+	}
+
+	var merged, defaultTags []monitorTagModel
+	if !tags.IsNull() {
+		tags.ElementsAs(ctx, &merged, false)
+	}
+	if !defaults.IsNull() {
+		defaults.ElementsAs(ctx, &defaultTags, false)
+	}
+
+	configured := merged
+	for _, d := range defaultTags {
+		overridden := false
+		for _, t := range configured {
+			sameID := !d.TagID.IsNull() && !d.TagID.IsUnknown() && t.TagID.Equal(d.TagID)
+			sameName := !d.Name.IsNull() && t.Name.Equal(d.Name)
+			if sameID || sameName {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged = append(merged, d)
+		}
+	}
+
+	if len(merged) == 0 {
+		return types.SetNull(objType)
+	}
+	set, _ := types.SetValueFrom(ctx, objType, merged)
+	return set
+}
+
+// planMonitorDefaults plans the monitor_defaults of the provider for the
+// shared attributes that are not configured, so that the plan shows the
+// effective values. Without a provider default, the schema default applies.
+func planMonitorDefaults(ctx context.Context, c *client.Client, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var defaults client.MonitorDefaults
+	if c != nil {
+		defaults = c.MonitorDefaults
+	}
+
+	int64Defaults := map[string]*int64{
+		"interval":        defaults.Interval,
+		"retry_interval":  defaults.RetryInterval,
+		"resend_interval": defaults.ResendInterval,
+		"max_retries":     defaults.MaxRetries,
+	}
+	for name, value := range int64Defaults {
+		var configured types.Int64
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &configured)...)
+		if value != nil && configured.IsNull() {
+			diags.Append(plan.SetAttribute(ctx, path.Root(name), types.Int64PointerValue(value))...)
+		}
+	}
+
+	var upsideDown types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root("upside_down"), &upsideDown)...)
+	if defaults.UpsideDown != nil && upsideDown.IsNull() {
+		diags.Append(plan.SetAttribute(ctx, path.Root("upside_down"), types.BoolPointerValue(defaults.UpsideDown))...)
+	}
+
+	// Without a default, no notifications are planned rather than an unknown list
+	var notificationIDs types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("notification_id_list"), &notificationIDs)...)
+	if notificationIDs.IsNull() {
+		value := types.ListNull(types.Int64Type)
+		if len(defaults.NotificationIDs) > 0 {
+			var d diag.Diagnostics
+			value, d = types.ListValueFrom(ctx, types.Int64Type, defaults.NotificationIDs)
+			diags.Append(d...)
+		}
+		diags.Append(plan.SetAttribute(ctx, path.Root("notification_id_list"), value)...)
+	}
+
+	return diags
+}
+
+// setMonitorActive pauses or resumes a monitor. The active field of create
+// and update requests is not reliable, so the dedicated API calls are used.
+func setMonitorActive(ctx context.Context, c *client.Client, monitorID int64, active bool) error {
+	if active {
+		if err := c.Kuma.ResumeMonitor(ctx, monitorID); err != nil {
+			return fmt.Errorf("unable to resume monitor %d: %w", monitorID, err)
+		}
+		return nil
+	}
+
+	if err := c.Kuma.PauseMonitor(ctx, monitorID); err != nil {
+		return fmt.Errorf("unable to pause monitor %d: %w", monitorID, err)
+	}
+	return nil
+}
+
+// monitorForUpdate returns the monitor to send when updating monitorID: the
+// monitor stored in Uptime Kuma with the fields of planned overlaid. Uptime
+// Kuma replaces the whole monitor on update, so sending planned alone would
+// reset the fields set outside of Terraform, for example in the UI. prior is
+// the monitor of the prior state, used to tell which null planned fields were
+// removed from the configuration; it may be nil.
+func monitorForUpdate(ctx context.Context, c *client.Client, monitorID int64, prior, planned kumamonitor.Monitor) (kumamonitor.Monitor, error) {
+	current, err := c.Kuma.GetMonitor(ctx, monitorID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read monitor %d: %w", monitorID, err)
+	}
+	var stored kumamonitor.Generic
+	if err := current.As(&stored); err != nil {
+		return nil, fmt.Errorf("failed to convert monitor %d: %w", monitorID, err)
+	}
+
+	plannedJSON, err := json.Marshal(planned)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode monitor %d: %w", monitorID, err)
+	}
+	var plannedFields map[string]any
+	if err := json.Unmarshal(plannedJSON, &plannedFields); err != nil {
+		return nil, fmt.Errorf("unable to encode monitor %d: %w", monitorID, err)
+	}
+
+	var priorFields map[string]any
+	if prior != nil {
+		priorJSON, err := json.Marshal(prior)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode monitor %d: %w", monitorID, err)
+		}
+		if err := json.Unmarshal(priorJSON, &priorFields); err != nil {
+			return nil, fmt.Errorf("unable to encode monitor %d: %w", monitorID, err)
+		}
+	}
+
+	merged := &kumamonitor.Generic{
+		MonitorType: planned.Type(),
+		Fields:      overlayMonitorFields(stored.Fields, priorFields, plannedFields),
+	}
+	if err := json.Unmarshal(plannedJSON, &merged.Base); err != nil {
+		return nil, fmt.Errorf("unable to encode monitor %d: %w", monitorID, err)
+	}
+	return merged, nil
+}
+
+// overlayMonitorFields returns the stored monitor fields with the planned
+// fields applied. A field the prior state set and the plan no longer sets was
+// removed from the configuration and is cleared. Other null planned fields
+// keep their stored value, since the provider leaves the fields it does not
+// manage unset.
+func overlayMonitorFields(stored, prior, planned map[string]any) map[string]any {
+	fields := maps.Clone(stored)
+	if fields == nil {
+		fields = make(map[string]any, len(planned))
+	}
+	for key, value := range planned {
+		if value != nil {
+			fields[key] = value
+		}
+	}
+	for key, value := range prior {
+		if value != nil && planned[key] == nil {
+			fields[key] = nil
+		}
+	}
+	return fields
+}
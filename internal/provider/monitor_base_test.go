@@ -0,0 +1,225 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/breml/go-uptime-kuma-client/tag"
+	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
+)
+
+func TestOverlayMonitorFields(t *testing.T) {
+	stored := map[string]any{
+		"name":        "Old Name",
+		"url":         "https://old.example.com",
+		"description": "Set in the UI",
+		"proxyId":     float64(3),
+	}
+	planned := map[string]any{
+		"name":        "New Name",
+		"url":         "https://example.com",
+		"description": nil,
+		"ignoreTls":   false,
+	}
+
+	got := overlayMonitorFields(stored, nil, planned)
+	want := map[string]any{
+		"name":        "New Name",
+		"url":         "https://example.com",
+		"description": "Set in the UI",
+		"proxyId":     float64(3),
+		"ignoreTls":   false,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if stored["name"] != "Old Name" {
+		t.Errorf("stored fields were modified: %v", stored)
+	}
+
+	if got := overlayMonitorFields(nil, nil, planned); len(got) != 3 {
+		t.Errorf("expected the non-null planned fields, got %v", got)
+	}
+}
+
+func TestOverlayMonitorFieldsClearsRemovedFields(t *testing.T) {
+	stored := map[string]any{
+		"name":          "Browser",
+		"remoteBrowser": float64(2),
+		"description":   "Runbook",
+		"proxyId":       float64(3),
+	}
+	// remote_browser_id was configured and has been removed, the proxy was
+	// only set in the UI
+	prior := map[string]any{
+		"name":          "Browser",
+		"remoteBrowser": float64(2),
+		"description":   "Runbook",
+		"proxyId":       nil,
+	}
+	planned := map[string]any{
+		"name":          "Browser",
+		"remoteBrowser": nil,
+		"description":   "Runbook",
+		"proxyId":       nil,
+	}
+
+	got := overlayMonitorFields(stored, prior, planned)
+	want := map[string]any{
+		"name":          "Browser",
+		"remoteBrowser": nil,
+		"description":   "Runbook",
+		"proxyId":       float64(3),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func testMonitorTagsSet(t *testing.T, tags ...monitorTagModel) types.Set {
+	t.Helper()
+
+	if len(tags) == 0 {
+		return types.SetNull(types.ObjectType{AttrTypes: monitorTagAttrTypes()})
+	}
+	set, diags := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: monitorTagAttrTypes()}, tags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return set
+}
+
+func testMonitorTag(tagID int64, name, value string) monitorTagModel {
+	tm := monitorTagModel{
+		TagID: types.Int64Value(tagID),
+		Name:  types.StringNull(),
+		Color: types.StringNull(),
+		Value: types.StringNull(),
+	}
+	if name != "" {
+		tm.Name = types.StringValue(name)
+	}
+	if value != "" {
+		tm.Value = types.StringValue(value)
+	}
+	return tm
+}
+
+func TestMergeMonitorTags(t *testing.T) {
+	ctx := context.Background()
+
+	defaults := testMonitorTagsSet(t,
+		testMonitorTag(3, "", "terraform"),
+		testMonitorTag(7, "team", "sre"),
+	)
+
+	tests := map[string]struct {
+		tags types.Set
+		want types.Set
+	}{
+		"no tags": {
+			tags: testMonitorTagsSet(t),
+			want: defaults,
+		},
+		"other tags": {
+			tags: testMonitorTagsSet(t, testMonitorTag(5, "", "")),
+			want: testMonitorTagsSet(t,
+				testMonitorTag(5, "", ""),
+				testMonitorTag(3, "", "terraform"),
+				testMonitorTag(7, "team", "sre"),
+			),
+		},
+		"overridden by ID": {
+			tags: testMonitorTagsSet(t, testMonitorTag(3, "", "manual")),
+			want: testMonitorTagsSet(t,
+				testMonitorTag(3, "", "manual"),
+				testMonitorTag(7, "team", "sre"),
+			),
+		},
+		"overridden by name": {
+			tags: testMonitorTagsSet(t,
+				monitorTagModel{TagID: types.Int64Unknown(), Name: types.StringValue("team"), Color: types.StringNull(), Value: types.StringValue("api")},
+				monitorTagModel{TagID: types.Int64Unknown(), Name: types.StringValue("team"), Color: types.StringNull(), Value: types.StringValue("payments")},
+			),
+			want: testMonitorTagsSet(t,
+				monitorTagModel{TagID: types.Int64Unknown(), Name: types.StringValue("team"), Color: types.StringNull(), Value: types.StringValue("api")},
+				monitorTagModel{TagID: types.Int64Unknown(), Name: types.StringValue("team"), Color: types.StringNull(), Value: types.StringValue("payments")},
+				testMonitorTag(3, "", "terraform"),
+			),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := mergeMonitorTags(ctx, tt.tags, defaults)
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if got := mergeMonitorTags(ctx, testMonitorTagsSet(t), testMonitorTagsSet(t)); !got.IsNull() {
+		t.Errorf("expected null without tags, got %v", got)
+	}
+	if got := mergeMonitorTags(ctx, types.SetUnknown(types.ObjectType{AttrTypes: monitorTagAttrTypes()}), defaults); !got.IsUnknown() {
+		t.Errorf("expected unknown with unknown tags, got %v", got)
+	}
+}
+
+func TestDefaultMonitorTagsValue(t *testing.T) {
+	got := defaultMonitorTagsValue(context.Background(), []client.MonitorTag{
+		{TagID: 3, Value: "terraform"},
+		{Name: "team", Color: "#2563EB", Value: "sre"},
+	})
+	want := testMonitorTagsSet(t,
+		testMonitorTag(3, "", "terraform"),
+		monitorTagModel{TagID: types.Int64Null(), Name: types.StringValue("team"), Color: types.StringValue("#2563EB"), Value: types.StringValue("sre")},
+	)
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSetTagsFromKuma(t *testing.T) {
+	ctx := context.Background()
+
+	m := MonitorBaseModel{
+		Tags: testMonitorTagsSet(t, testMonitorTag(5, "env", "prod")),
+		TagsAll: testMonitorTagsSet(t,
+			testMonitorTag(5, "env", "prod"),
+			testMonitorTag(7, "team", "sre"),
+		),
+	}
+	m.setTagsFromKuma(ctx, []tag.MonitorTag{
+		{TagID: 5, Value: "prod"},
+		{TagID: 7, Value: "sre"},
+		{TagID: 9, Value: "added-in-ui"},
+	})
+
+	// Default tags stay out of tags, tags added outside of Terraform show up
+	wantTags := testMonitorTagsSet(t,
+		testMonitorTag(5, "env", "prod"),
+		testMonitorTag(9, "", "added-in-ui"),
+	)
+	if !m.Tags.Equal(wantTags) {
+		t.Errorf("expected tags %v, got %v", wantTags, m.Tags)
+	}
+
+	var all []monitorTagModel
+	m.TagsAll.ElementsAs(ctx, &all, false)
+	var names []string
+	for _, tm := range all {
+		names = append(names, tm.Name.ValueString())
+	}
+	sort.Strings(names)
+	if want := []string{"", "env", "team"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("expected tags_all names %v, got %v", want, names)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+// conditionStringOperators are the operators of Uptime Kuma monitor
+// conditions on string variables.
+var conditionStringOperators = []string{
+	"==", "!=", "contains", "!contains", "starts_with", "!starts_with", "ends_with", "!ends_with",
+}
+
+// monitorConditionVariables holds the variables the conditions of each
+// monitor type can check, with the operators each variable supports.
+// Conditions are not supported by monitor types without an entry: Uptime Kuma
+// only evaluates them for dns monitors, other types such as snmp compare their
+// result with json_path, json_path_operator and expected_value instead.
+var monitorConditionVariables = map[string]map[string][]string{
+	"dns": {
+		"record": conditionStringOperators,
+	},
+}
+
+// monitorConditionGroupModel describes a group of the conditions attribute.
+type monitorConditionGroupModel struct {
+	AndOr   types.String `tfsdk:"and_or"`
+	Clauses types.List   `tfsdk:"clauses"`
+}
+
+// monitorConditionClauseModel describes a clause of a condition group.
+type monitorConditionClauseModel struct {
+	AndOr    types.String `tfsdk:"and_or"`
+	Variable types.String `tfsdk:"variable"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+// kumaCondition is an entry of the condition JSON of Uptime Kuma: either an
+// expression or a group of conditions.
+type kumaCondition struct {
+	Type     string          `json:"type"`
+	AndOr    string          `json:"andOr"`
+	Variable *string         `json:"variable,omitempty"`
+	Operator *string         `json:"operator,omitempty"`
+	Value    *string         `json:"value,omitempty"`
+	Children []kumaCondition `json:"children,omitempty"`
+}
+
+// monitorConditionClauseAttrTypes returns the attribute types of a condition clause.
+func monitorConditionClauseAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"and_or":   types.StringType,
+		"variable": types.StringType,
+		"operator": types.StringType,
+		"value":    types.StringType,
+	}
+}
+
+// monitorConditionGroupAttrTypes returns the attribute types of a condition group.
+func monitorConditionGroupAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"and_or":  types.StringType,
+		"clauses": types.ListType{ElemType: types.ObjectType{AttrTypes: monitorConditionClauseAttrTypes()}},
+	}
+}
+
+// monitorConditionsSchemaAttribute returns the schema of the conditions attribute.
+func monitorConditionsSchemaAttribute() schema.ListNestedAttribute {
+	andOr := func(subject string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("How the %s is combined with the previous one (and, or). Ignored on the first %s. Defaults to and.", subject, subject),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("and"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("and", "or"),
+			},
+		}
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Groups of conditions the check result must meet. Uptime Kuma only evaluates conditions for dns monitors, they are rejected for other monitor types. The variables and operators are checked against the monitor type at plan time.",
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"and_or": andOr("group"),
+				"clauses": schema.ListNestedAttribute{
+					MarkdownDescription: "Clauses of the group",
+					Required:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"and_or": andOr("clause"),
+							"variable": schema.StringAttribute{
+								MarkdownDescription: "Variable to check, e.g. record for dns monitors",
+								Required:            true,
+							},
+							"operator": schema.StringAttribute{
+								MarkdownDescription: "Comparison operator supported by the variable, e.g. ==, !=, contains, !contains, starts_with, !starts_with, ends_with or !ends_with for record",
+								Required:            true,
+							},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "Value the variable is compared with",
+								Required:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// monitorConditions converts the conditions attribute into the condition
+// JSON of Uptime Kuma. Each group is sent as a condition group.
+func monitorConditions(ctx context.Context, conditions types.List) ([]kumaCondition, error) {
+	var groups []monitorConditionGroupModel
+	if diags := conditions.ElementsAs(ctx, &groups, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid conditions: %v", diags)
+	}
+
+	out := make([]kumaCondition, 0, len(groups))
+	for _, group := range groups {
+		var clauses []monitorConditionClauseModel
+		if diags := group.Clauses.ElementsAs(ctx, &clauses, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid conditions: %v", diags)
+		}
+
+		children := make([]kumaCondition, 0, len(clauses))
+		for _, clause := range clauses {
+			children = append(children, kumaCondition{
+				Type:     "expression",
+				AndOr:    clause.AndOr.ValueString(),
+				Variable: clause.Variable.ValueStringPointer(),
+				Operator: clause.Operator.ValueStringPointer(),
+				Value:    clause.Value.ValueStringPointer(),
+			})
+		}
+		out = append(out, kumaCondition{
+			Type:     "group",
+			AndOr:    group.AndOr.ValueString(),
+			Children: children,
+		})
+	}
+	return out, nil
+}
+
+// withMonitorConditions returns the monitor with the conditions attribute
+// set. The library monitor types do not model conditions, so the monitor is
+// sent as a generic monitor with the conditions field added. Null conditions
+// clear the stored conditions.
+func withMonitorConditions(ctx context.Context, m kumamonitor.Monitor, conditions types.List) (kumamonitor.Monitor, error) {
+	kumaConditions := []kumaCondition{}
+	if !conditions.IsNull() && !conditions.IsUnknown() {
+		var err error
+		if kumaConditions, err = monitorConditions(ctx, conditions); err != nil {
+			return nil, err
+		}
+	}
+
+	encoded, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode monitor: %w", err)
+	}
+	generic := &kumamonitor.Generic{MonitorType: m.Type()}
+	if err := json.Unmarshal(encoded, &generic.Fields); err != nil {
+		return nil, fmt.Errorf("unable to encode monitor: %w", err)
+	}
+	if err := json.Unmarshal(encoded, &generic.Base); err != nil {
+		return nil, fmt.Errorf("unable to encode monitor: %w", err)
+	}
+	generic.Fields["conditions"] = kumaConditions
+	return generic, nil
+}
+
+// monitorConditionsValue converts the condition JSON returned by Uptime Kuma
+// into the conditions attribute value. Uptime Kuma returns either the parsed
+// JSON or the JSON string. Expressions outside of a group, which the UI can
+// create, are read as a group with a single clause.
+func monitorConditionsValue(ctx context.Context, raw any) (types.List, error) {
+	elemType := types.ObjectType{AttrTypes: monitorConditionGroupAttrTypes()}
+
+	data, ok := raw.(string)
+	if !ok {
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return types.ListNull(elemType), err
+		}
+		data = string(encoded)
+	}
+
+	var conditions []kumaCondition
+	if data != "" && data != "null" {
+		if err := json.Unmarshal([]byte(data), &conditions); err != nil {
+			return types.ListNull(elemType), fmt.Errorf("invalid conditions: %w", err)
+		}
+	}
+	if len(conditions) == 0 {
+		return types.ListNull(elemType), nil
+	}
+
+	groups := make([]monitorConditionGroupModel, 0, len(conditions))
+	for _, condition := range conditions {
+		children := condition.Children
+		andOr := condition.AndOr
+		if condition.Type != "group" {
+			children = []kumaCondition{condition}
+		}
+
+		clauses := make([]monitorConditionClauseModel, 0, len(children))
+		for _, child := range children {
+			clauses = append(clauses, monitorConditionClauseModel{
+				AndOr:    types.StringValue(child.AndOr),
+				Variable: types.StringPointerValue(child.Variable),
+				Operator: types.StringPointerValue(child.Operator),
+				Value:    types.StringPointerValue(child.Value),
+			})
+		}
+		clauseList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: monitorConditionClauseAttrTypes()}, clauses)
+		if diags.HasError() {
+			return types.ListNull(elemType), fmt.Errorf("invalid conditions: %v", diags)
+		}
+		groups = append(groups, monitorConditionGroupModel{
+			AndOr:   types.StringValue(andOr),
+			Clauses: clauseList,
+		})
+	}
+
+	value, diags := types.ListValueFrom(ctx, elemType, groups)
+	if diags.HasError() {
+		return types.ListNull(elemType), fmt.Errorf("invalid conditions: %v", diags)
+	}
+	return value, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func TestMonitorConditionsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	raw := `[
+		{"type": "group", "andOr": "and", "children": [
+			{"type": "expression", "andOr": "and", "variable": "record", "operator": "contains", "value": "10.0."},
+			{"type": "expression", "andOr": "or", "variable": "record", "operator": "==", "value": "192.0.2.1"}
+		]},
+		{"type": "expression", "andOr": "and", "variable": "record", "operator": "!ends_with", "value": ".local"}
+	]`
+
+	// Both the parsed JSON and the JSON string are accepted
+	var parsed any
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	fromParsed, err := monitorConditionsValue(ctx, parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromString, err := monitorConditionsValue(ctx, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fromParsed.Equal(fromString) {
+		t.Fatalf("expected the same value, got %s and %s", fromParsed, fromString)
+	}
+
+	conditions, err := monitorConditions(ctx, fromParsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(conditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The top-level expression is sent as a group with a single clause
+	want := `[` +
+		`{"type":"group","andOr":"and","children":[` +
+		`{"type":"expression","andOr":"and","variable":"record","operator":"contains","value":"10.0."},` +
+		`{"type":"expression","andOr":"or","variable":"record","operator":"==","value":"192.0.2.1"}]},` +
+		`{"type":"group","andOr":"and","children":[` +
+		`{"type":"expression","andOr":"and","variable":"record","operator":"!ends_with","value":".local"}]}]`
+	if string(got) != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	for _, empty := range []any{nil, "", "[]", []any{}} {
+		value, err := monitorConditionsValue(ctx, empty)
+		if err != nil || !value.IsNull() {
+			t.Errorf("%#v: expected null conditions, got %s (%v)", empty, value, err)
+		}
+	}
+}
+
+func TestWithMonitorConditions(t *testing.T) {
+	ctx := context.Background()
+
+	conditions, err := monitorConditionsValue(ctx, `[{"type": "group", "andOr": "and", "children": [
+		{"type": "expression", "andOr": "and", "variable": "record", "operator": "==", "value": "192.0.2.1"}
+	]}]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dns := &kumamonitor.DNS{Base: kumamonitor.Base{ID: 7, Name: "DNS"}}
+	got, err := withMonitorConditions(ctx, dns, conditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	generic, ok := got.(*kumamonitor.Generic)
+	if !ok {
+		t.Fatalf("expected a generic monitor, got %T", got)
+	}
+	if generic.MonitorType != "dns" || generic.ID != 7 || generic.Name != "DNS" {
+		t.Errorf("expected the dns monitor 7 named DNS, got %q %d %q", generic.MonitorType, generic.ID, generic.Name)
+	}
+	if sent, ok := generic.Fields["conditions"].([]kumaCondition); !ok || len(sent) != 1 || len(sent[0].Children) != 1 {
+		t.Errorf("expected one condition group with one clause, got %#v", generic.Fields["conditions"])
+	}
+
+	// Null conditions clear the stored conditions
+	got, err = withMonitorConditions(ctx, dns, types.ListNull(types.ObjectType{AttrTypes: monitorConditionGroupAttrTypes()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent, ok := got.(*kumamonitor.Generic).Fields["conditions"].([]kumaCondition); !ok || len(sent) != 0 {
+		t.Errorf("expected empty conditions, got %#v", got.(*kumamonitor.Generic).Fields["conditions"])
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func NewMonitorDNSResource() resource.Resource {
+	return &typedMonitorResource{
+		monitorType:    "dns",
+		typeNameSuffix: "_monitor_dns",
+		description:    "Uptime Kuma DNS monitor resource. Checks that a resolver answers queries for a record.",
+		attributes: func() map[string]schema.Attribute {
+			return map[string]schema.Attribute{
+				"hostname": schema.StringAttribute{
+					MarkdownDescription: "Record name to resolve",
+					Required:            true,
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "Port of the resolver. Defaults to 53.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(53),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"dns_resolve_server": schema.StringAttribute{
+					MarkdownDescription: "Resolver to query. Defaults to 1.1.1.1.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("1.1.1.1"),
+				},
+				"dns_resolve_type": schema.StringAttribute{
+					MarkdownDescription: "Record type to query (A, AAAA, CAA, CNAME, MX, NS, PTR, SOA, SRV, TXT). Defaults to A.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("A"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"),
+					},
+				},
+				"conditions": monitorConditionsSchemaAttribute(),
+			}
+		},
+		newModel: func() typedMonitorModel { return &MonitorDNSResourceModel{} },
+	}
+}
+
+// MonitorDNSResourceModel describes the uptimekuma_monitor_dns data model.
+type MonitorDNSResourceModel struct {
+	MonitorBaseModel
+	Hostname         types.String `tfsdk:"hostname"`
+	Port             types.Int64  `tfsdk:"port"`
+	DNSResolveServer types.String `tfsdk:"dns_resolve_server"`
+	DNSResolveType   types.String `tfsdk:"dns_resolve_type"`
+	Conditions       types.List   `tfsdk:"conditions"`
+}
+
+func (m *MonitorDNSResourceModel) base() *MonitorBaseModel {
+	return &m.MonitorBaseModel
+}
+
+func (m *MonitorDNSResourceModel) toMonitor(ctx context.Context) (kumamonitor.Monitor, error) {
+	monitor := &kumamonitor.DNS{
+		Base: m.kumaBase(ctx),
+		DNSDetails: kumamonitor.DNSDetails{
+			Hostname:         m.Hostname.ValueString(),
+			Port:             int(m.Port.ValueInt64()),
+			DNSResolveServer: m.DNSResolveServer.ValueString(),
+			DNSResolveType:   m.DNSResolveType.ValueString(),
+		},
+	}
+	return withMonitorConditions(ctx, monitor, m.Conditions)
+}
+
+func (m *MonitorDNSResourceModel) fromMonitor(ctx context.Context, b kumamonitor.Base) error {
+	var v kumamonitor.DNS
+	if err := b.As(&v); err != nil {
+		return err
+	}
+
+	m.setFromKuma(ctx, v.Base)
+	m.Hostname = stringValueOrNull(v.Hostname)
+	m.Port = types.Int64Value(int64(v.Port))
+	m.DNSResolveServer = types.StringValue(v.DNSResolveServer)
+	m.DNSResolveType = types.StringValue(v.DNSResolveType)
+
+	// The library does not model conditions, read them from the generic fields
+	var generic kumamonitor.Generic
+	if err := b.As(&generic); err != nil {
+		return err
+	}
+	conditions, err := monitorConditionsValue(ctx, generic.Fields["conditions"])
+	if err != nil {
+		return err
+	}
+	m.Conditions = conditions
+	return nil
+}
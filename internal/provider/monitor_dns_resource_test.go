@@ -0,0 +1,170 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMonitorDNSResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorDNSResourceConfig("DNS Monitor", "A"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("hostname"),
+						knownvalue.StringExact("example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("dns_resolve_type"),
+						knownvalue.StringExact("A"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("dns_resolve_server"),
+						knownvalue.StringExact("1.1.1.1"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("port"),
+						knownvalue.Int64Exact(53),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_monitor_dns.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMonitorDNSResourceConfig("DNS Monitor", "AAAA"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("dns_resolve_type"),
+						knownvalue.StringExact("AAAA"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorDNSResourceConfig(name string, recordType string) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_dns" "test" {
+  name             = %[4]q
+  hostname         = "example.com"
+  dns_resolve_type = %[5]q
+  interval         = 60
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		name, recordType)
+}
+
+func TestAccMonitorDNSResourceConditions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorDNSResourceConditionsConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("conditions"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"and_or": knownvalue.StringExact("and"),
+								"clauses": knownvalue.ListExact([]knownvalue.Check{
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"and_or":   knownvalue.StringExact("and"),
+										"variable": knownvalue.StringExact("record"),
+										"operator": knownvalue.StringExact("contains"),
+										"value":    knownvalue.StringExact("93.184."),
+									}),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_monitor_dns.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the conditions clears them
+			{
+				Config: testAccMonitorDNSResourceConditionsConfig(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_dns.test",
+						tfjsonpath.New("conditions"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorDNSResourceConditionsConfig(withConditions bool) string {
+	conditions := ""
+	if withConditions {
+		conditions = `
+  conditions = [{
+    clauses = [{
+      variable = "record"
+      operator = "contains"
+      value    = "93.184."
+    }]
+  }]`
+	}
+
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_dns" "test" {
+  name     = "DNS Conditions Monitor"
+  hostname = "example.com"
+  interval = 60
+%[4]s
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		conditions)
+}
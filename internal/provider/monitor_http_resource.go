@@ -0,0 +1,374 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func NewMonitorHTTPResource() resource.Resource {
+	return &typedMonitorResource{
+		monitorType:    "http",
+		typeNameSuffix: "_monitor_http",
+		description:    "Uptime Kuma HTTP(s) monitor resource. Checks that a URL responds with an accepted status code.",
+		attributes:     monitorHTTPSchemaAttributes,
+		newModel:       func() typedMonitorModel { return &MonitorHTTPResourceModel{} },
+	}
+}
+
+// MonitorHTTPResourceModel describes the uptimekuma_monitor_http data model.
+type MonitorHTTPResourceModel struct {
+	MonitorBaseModel
+	monitorHTTPModel
+}
+
+// monitorHTTPModel describes the request attributes of http and keyword
+// monitors, shared by uptimekuma_monitor and the http and keyword monitor
+// resources.
+type monitorHTTPModel struct {
+	URL                 types.String     `tfsdk:"url"`
+	Method              types.String     `tfsdk:"method"`
+	IgnoreTLS           types.Bool       `tfsdk:"ignore_tls"`
+	Timeout             types.Float64    `tfsdk:"timeout"`
+	HTTPBodyEncoding    types.String     `tfsdk:"http_body_encoding"`
+	CacheBust           types.Bool       `tfsdk:"cache_bust"`
+	ExpiryNotification  types.Bool       `tfsdk:"expiry_notification"`
+	ProxyID             types.Int64      `tfsdk:"proxy_id"`
+	MaxRedirects        types.Int64      `tfsdk:"max_redirects"`
+	Body                types.String     `tfsdk:"body"`
+	Headers             JSONObjectString `tfsdk:"headers"`
+	RequestHeaders      types.Map        `tfsdk:"request_headers"`
+	SensitiveHeaders    types.Map        `tfsdk:"sensitive_headers"`
+	AuthMethod          types.String     `tfsdk:"auth_method"`
+	BasicAuthUser       types.String     `tfsdk:"basic_auth_user"`
+	BasicAuthPass       types.String     `tfsdk:"basic_auth_pass"`
+	AuthDomain          types.String     `tfsdk:"auth_domain"`
+	AuthWorkstation     types.String     `tfsdk:"auth_workstation"`
+	TLSCert             types.String     `tfsdk:"tls_cert"`
+	TLSKey              types.String     `tfsdk:"tls_key"`
+	TLSCa               types.String     `tfsdk:"tls_ca"`
+	OAuthClientID       types.String     `tfsdk:"oauth_client_id"`
+	OAuthClientSecret   types.String     `tfsdk:"oauth_client_secret"`
+	OAuthTokenURL       types.String     `tfsdk:"oauth_token_url"`
+	OAuthScopes         types.String     `tfsdk:"oauth_scopes"`
+	OAuthAuthMethod     types.String     `tfsdk:"oauth_auth_method"`
+	AcceptedStatusCodes types.Set        `tfsdk:"accepted_status_codes"`
+}
+
+// monitorHTTPSchemaAttributes returns the request attributes shared by the http and keyword monitor resources.
+func monitorHTTPSchemaAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			MarkdownDescription: "URL to monitor",
+			Required:            true,
+		},
+		"method": schema.StringAttribute{
+			MarkdownDescription: "HTTP method (GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS). Defaults to GET.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("GET"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"),
+			},
+		},
+		"ignore_tls": schema.BoolAttribute{
+			MarkdownDescription: "Ignore TLS/SSL errors",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"max_redirects": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of redirects to follow",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+		},
+		"body": schema.StringAttribute{
+			MarkdownDescription: "Request body",
+			Optional:            true,
+		},
+		"headers": schema.StringAttribute{
+			MarkdownDescription: "Request headers as a JSON object. Formatting and key order differences are ignored. Conflicts with `request_headers` and `sensitive_headers`.",
+			CustomType:          JSONObjectStringType{},
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("request_headers"), path.MatchRoot("sensitive_headers")),
+			},
+		},
+		"request_headers": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Request headers, by header name",
+			Optional:            true,
+		},
+		"sensitive_headers": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Request headers whose values are hidden in plans, such as authorization tokens. Sent together with `request_headers`.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"timeout": schema.Float64Attribute{
+			MarkdownDescription: "Request timeout in seconds. Defaults to 80% of `interval`, like the Uptime Kuma UI.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
+			},
+		},
+		"http_body_encoding": schema.StringAttribute{
+			MarkdownDescription: "Encoding of the request body (json, xml, form). Defaults to json.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(defaultHTTPBodyEncoding, "xml", "form"),
+			},
+		},
+		"cache_bust": schema.BoolAttribute{
+			MarkdownDescription: "Add a random query parameter to each request to bypass caches",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"expiry_notification": schema.BoolAttribute{
+			MarkdownDescription: "Send a notification before the TLS certificate of the URL expires",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"proxy_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of an `uptimekuma_proxy` to send the requests through. Removing it detaches the monitor from the proxy.",
+			Optional:            true,
+		},
+		"accepted_status_codes": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Accepted HTTP status codes, as single codes or ranges (e.g., [\"200-299\", \"304\"]). Defaults to [\"200-299\"].",
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(acceptedStatusCodesValue(context.Background(), nil)),
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(acceptedStatusCodeValidator()),
+			},
+		},
+	}
+	maps.Copy(attributes, monitorHTTPAuthSchemaAttributes())
+	return attributes
+}
+
+// monitorHTTPAuthSchemaAttributes returns the authentication attributes of
+// http and keyword monitors, shared by uptimekuma_monitor and the http and
+// keyword monitor resources.
+func monitorHTTPAuthSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auth_method": schema.StringAttribute{
+			MarkdownDescription: "Authentication method (basic, ntlm, mtls, oauth2-cc)",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("basic", "ntlm", "mtls", "oauth2-cc"),
+			},
+		},
+		"basic_auth_user": schema.StringAttribute{
+			MarkdownDescription: "Username for basic and ntlm authentication",
+			Optional:            true,
+		},
+		"basic_auth_pass": schema.StringAttribute{
+			MarkdownDescription: "Password for basic and ntlm authentication",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"auth_domain": schema.StringAttribute{
+			MarkdownDescription: "Domain for ntlm authentication",
+			Optional:            true,
+		},
+		"auth_workstation": schema.StringAttribute{
+			MarkdownDescription: "Workstation for ntlm authentication",
+			Optional:            true,
+		},
+		"tls_cert": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded client certificate for mtls authentication",
+			Optional:            true,
+		},
+		"tls_key": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded client private key for mtls authentication",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"tls_ca": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded CA certificate used to verify the server for mtls authentication",
+			Optional:            true,
+		},
+		"oauth_client_id": schema.StringAttribute{
+			MarkdownDescription: "Client ID for oauth2-cc (OAuth2 client credentials) authentication",
+			Optional:            true,
+		},
+		"oauth_client_secret": schema.StringAttribute{
+			MarkdownDescription: "Client secret for oauth2-cc authentication",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"oauth_token_url": schema.StringAttribute{
+			MarkdownDescription: "Token endpoint URL for oauth2-cc authentication",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+			},
+		},
+		"oauth_scopes": schema.StringAttribute{
+			MarkdownDescription: "Space separated scopes to request for oauth2-cc authentication",
+			Optional:            true,
+		},
+		"oauth_auth_method": schema.StringAttribute{
+			MarkdownDescription: "How the client credentials are sent to the token endpoint for oauth2-cc authentication (client_secret_basic, client_secret_post). Defaults to client_secret_basic.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(oauthAuthMethodSecretBasic, "client_secret_post"),
+			},
+		},
+	}
+}
+
+func (m *MonitorHTTPResourceModel) base() *MonitorBaseModel {
+	return &m.MonitorBaseModel
+}
+
+func (m *MonitorHTTPResourceModel) toMonitor(ctx context.Context) (kumamonitor.Monitor, error) {
+	httpDetails, err := m.httpDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &kumamonitor.HTTP{
+		Base:        m.kumaBase(ctx),
+		HTTPDetails: httpDetails,
+	}, nil
+}
+
+func (m *MonitorHTTPResourceModel) fromMonitor(ctx context.Context, b kumamonitor.Base) error {
+	var v kumamonitor.HTTP
+	if err := b.As(&v); err != nil {
+		return err
+	}
+
+	m.setFromKuma(ctx, v.Base)
+	m.setHTTPAttributes(ctx, v.HTTPDetails)
+	return nil
+}
+
+// httpDetails converts the request attributes into the library HTTP details.
+func (m monitorHTTPModel) httpDetails(ctx context.Context) (kumamonitor.HTTPDetails, error) {
+	headers, err := m.headersJSON(ctx)
+	if err != nil {
+		return kumamonitor.HTTPDetails{}, err
+	}
+
+	details := kumamonitor.HTTPDetails{
+		URL:           m.URL.ValueString(),
+		Method:        m.Method.ValueString(),
+		IgnoreTLS:     m.IgnoreTLS.ValueBool(),
+		MaxRedirects:  int(m.MaxRedirects.ValueInt64()),
+		Body:          m.Body.ValueString(),
+		Headers:       headers,
+		AuthMethod:    kumamonitor.AuthMethod(m.AuthMethod.ValueString()),
+		BasicAuthUser: m.BasicAuthUser.ValueString(),
+		BasicAuthPass: m.BasicAuthPass.ValueString(),
+	}
+	if details.Method == "" {
+		details.Method = "GET"
+	}
+	m.setHTTPAuthDetails(&details)
+	m.setHTTPRequestOptions(&details)
+	details.AcceptedStatusCodes = acceptedStatusCodes(ctx, m.AcceptedStatusCodes)
+
+	return details, nil
+}
+
+// defaultAcceptedStatusCodes are the status codes Uptime Kuma accepts when
+// none are configured.
+var defaultAcceptedStatusCodes = []string{"200-299"}
+
+// acceptedStatusCodePattern matches a single HTTP status code or a range of
+// status codes, as used by Uptime Kuma.
+var acceptedStatusCodePattern = regexp.MustCompile(`^[1-5][0-9]{2}(-[1-5][0-9]{2})?$`)
+
+// acceptedStatusCodeValidator validates a single entry of accepted_status_codes.
+func acceptedStatusCodeValidator() validator.String {
+	return acceptedStatusCodeStringValidator{}
+}
+
+// acceptedStatusCodeStringValidator checks that a value is a status code or a
+// range of status codes whose lower bound does not exceed its upper bound.
+type acceptedStatusCodeStringValidator struct{}
+
+func (v acceptedStatusCodeStringValidator) Description(ctx context.Context) string {
+	return "must be an HTTP status code such as 200 or a range such as 200-299"
+}
+
+func (v acceptedStatusCodeStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v acceptedStatusCodeStringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	code := req.ConfigValue.ValueString()
+	if !acceptedStatusCodePattern.MatchString(code) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Match",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), code),
+		)
+		return
+	}
+
+	// Both bounds have three digits, so they compare like numbers
+	if low, high, isRange := strings.Cut(code, "-"); isRange && low > high {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s must be a range whose lower bound does not exceed its upper bound, got: %s", req.Path, code),
+		)
+	}
+}
+
+// acceptedStatusCodes converts accepted_status_codes into the library format,
+// falling back to the Uptime Kuma default when none are set.
+func acceptedStatusCodes(ctx context.Context, codes types.Set) []string {
+	if codes.IsNull() || codes.IsUnknown() || len(codes.Elements()) == 0 {
+		return slices.Clone(defaultAcceptedStatusCodes)
+	}
+
+	var out []string
+	codes.ElementsAs(ctx, &out, false)
+	return out
+}
+
+// acceptedStatusCodesValue converts the status codes returned by Uptime Kuma
+// into accepted_status_codes, mapping an empty list to the default.
+func acceptedStatusCodesValue(ctx context.Context, codes []string) types.Set {
+	if len(codes) == 0 {
+		codes = defaultAcceptedStatusCodes
+	}
+
+	value, _ := types.SetValueFrom(ctx, types.StringType, codes)
+	return value
+}
@@ -0,0 +1,250 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMonitorHTTPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorHTTPResourceConfig("HTTP Monitor", "https://example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("HTTP Monitor"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact("https://example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("method"),
+						knownvalue.StringExact("GET"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_monitor_http.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMonitorHTTPResourceConfig("HTTP Monitor Updated", "https://example.org"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("HTTP Monitor Updated"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact("https://example.org"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceConfig(name string, url string) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name     = %[4]q
+  url      = %[5]q
+  interval = 60
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		name, url)
+}
+
+func TestAccMonitorHTTPResourceUnsupportedAttribute(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMonitorHTTPResourceConfigWithHostname(),
+				ExpectError: regexp.MustCompile(`An argument named "hostname" is not expected here`),
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceConfigWithHostname() string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name     = "HTTP Monitor"
+  url      = "https://example.com"
+  hostname = "example.com"
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+	)
+}
+
+func TestAccMonitorHTTPResourceRequestOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorHTTPResourceRequestOptionsConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("auth_domain"),
+						knownvalue.StringExact("CORP"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("request_headers"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"Accept": knownvalue.StringExact("application/json"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("sensitive_headers"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"X-Api-Key": knownvalue.StringExact("secret"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("timeout"),
+						knownvalue.Float64Exact(30),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("http_body_encoding"),
+						knownvalue.StringExact("xml"),
+					),
+				},
+			},
+			// Re-applying the same configuration must not produce a diff
+			{
+				Config:   testAccMonitorHTTPResourceRequestOptionsConfig(),
+				PlanOnly: true,
+			},
+			// ImportState testing. Imported headers are read as headers,
+			// since the split into request_headers and sensitive_headers is
+			// only known from the configuration
+			{
+				ResourceName:      "uptimekuma_monitor_http.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"basic_auth_pass", "headers", "request_headers", "sensitive_headers",
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceRequestOptionsConfig() string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name               = "HTTP Request Options Monitor"
+  url                = "https://example.com"
+  interval           = 60
+  timeout            = 30
+  http_body_encoding = "xml"
+
+  auth_method     = "ntlm"
+  basic_auth_user = "kuma"
+  basic_auth_pass = "kuma-secret"
+  auth_domain     = "CORP"
+
+  request_headers = {
+    Accept = "application/json"
+  }
+  sensitive_headers = {
+    X-Api-Key = "secret"
+  }
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+	)
+}
+
+func TestAcceptedStatusCodeValidator(t *testing.T) {
+	tests := map[string]bool{
+		"200":     true,
+		"200-299": true,
+		"404-404": true,
+		"2xx":     false,
+		"600":     false,
+		"299-200": false,
+	}
+
+	for code, valid := range tests {
+		t.Run(code, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("accepted_status_codes"),
+				ConfigValue: types.StringValue(code),
+			}
+			resp := &validator.StringResponse{}
+			acceptedStatusCodeValidator().ValidateString(context.Background(), req, resp)
+
+			if got := !resp.Diagnostics.HasError(); got != valid {
+				t.Errorf("expected valid %t, got %t: %v", valid, got, resp.Diagnostics)
+			}
+		})
+	}
+}
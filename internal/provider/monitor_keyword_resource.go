@@ -0,0 +1,78 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func NewMonitorKeywordResource() resource.Resource {
+	return &typedMonitorResource{
+		monitorType:    "keyword",
+		typeNameSuffix: "_monitor_keyword",
+		description:    "Uptime Kuma HTTP(s) keyword monitor resource. Checks that the response of a URL contains a keyword.",
+		attributes: func() map[string]schema.Attribute {
+			attributes := monitorHTTPSchemaAttributes()
+			attributes["keyword"] = schema.StringAttribute{
+				MarkdownDescription: "Keyword to search for in the response",
+				Required:            true,
+			}
+			attributes["invert_keyword"] = schema.BoolAttribute{
+				MarkdownDescription: "Mark the monitor as DOWN when the keyword is found instead of when it is missing",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			}
+			return attributes
+		},
+		newModel: func() typedMonitorModel { return &MonitorKeywordResourceModel{} },
+	}
+}
+
+// MonitorKeywordResourceModel describes the uptimekuma_monitor_keyword data model.
+type MonitorKeywordResourceModel struct {
+	MonitorBaseModel
+	monitorHTTPModel
+	Keyword       types.String `tfsdk:"keyword"`
+	InvertKeyword types.Bool   `tfsdk:"invert_keyword"`
+}
+
+func (m *MonitorKeywordResourceModel) base() *MonitorBaseModel {
+	return &m.MonitorBaseModel
+}
+
+func (m *MonitorKeywordResourceModel) toMonitor(ctx context.Context) (kumamonitor.Monitor, error) {
+	httpDetails, err := m.httpDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &kumamonitor.HTTPKeyword{
+		Base:        m.kumaBase(ctx),
+		HTTPDetails: httpDetails,
+		HTTPKeywordDetails: kumamonitor.HTTPKeywordDetails{
+			Keyword:       m.Keyword.ValueString(),
+			InvertKeyword: m.InvertKeyword.ValueBool(),
+		},
+	}, nil
+}
+
+func (m *MonitorKeywordResourceModel) fromMonitor(ctx context.Context, b kumamonitor.Base) error {
+	var v kumamonitor.HTTPKeyword
+	if err := b.As(&v); err != nil {
+		return err
+	}
+
+	m.setFromKuma(ctx, v.Base)
+	m.setHTTPAttributes(ctx, v.HTTPDetails)
+	m.Keyword = stringValueOrNull(v.Keyword)
+	m.InvertKeyword = types.BoolValue(v.InvertKeyword)
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMonitorKeywordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorKeywordResourceConfig("Keyword Monitor", "Example Domain", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_keyword.test",
+						tfjsonpath.New("keyword"),
+						knownvalue.StringExact("Example Domain"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_keyword.test",
+						tfjsonpath.New("invert_keyword"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_monitor_keyword.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMonitorKeywordResourceConfig("Keyword Monitor", "Server Error", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_keyword.test",
+						tfjsonpath.New("keyword"),
+						knownvalue.StringExact("Server Error"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_keyword.test",
+						tfjsonpath.New("invert_keyword"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorKeywordResourceConfig(name string, keyword string, invert bool) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_keyword" "test" {
+  name           = %[4]q
+  url            = "https://example.com"
+  keyword        = %[5]q
+  invert_keyword = %[6]t
+  interval       = 60
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		name, keyword, invert)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func NewMonitorPingResource() resource.Resource {
+	return &typedMonitorResource{
+		monitorType:    "ping",
+		typeNameSuffix: "_monitor_ping",
+		description:    "Uptime Kuma ping monitor resource. Checks that a host answers ICMP echo requests.",
+		attributes: func() map[string]schema.Attribute {
+			return map[string]schema.Attribute{
+				"hostname": schema.StringAttribute{
+					MarkdownDescription: "Hostname or IP address to ping",
+					Required:            true,
+				},
+				"ping_count": schema.Int64Attribute{
+					MarkdownDescription: "Number of packets sent per check (1 to 100). Defaults to 1.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(defaultPingCount),
+					Validators: []validator.Int64{
+						int64validator.Between(minPingCount, maxPingCount),
+					},
+				},
+				"ping_numeric": schema.BoolAttribute{
+					MarkdownDescription: "Output numeric addresses only, skipping reverse DNS lookups. Defaults to true.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+				"ping_per_request_timeout": schema.Int64Attribute{
+					MarkdownDescription: "Timeout in seconds of each packet (1 to 60). Defaults to 2.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(defaultPingPerRequestTimeout),
+					Validators: []validator.Int64{
+						int64validator.Between(minPingPerRequestTimeout, maxPingPerRequestTimeout),
+					},
+				},
+				"timeout": schema.Float64Attribute{
+					MarkdownDescription: "Overall timeout of a check in seconds (1 to 300). Defaults to 10.",
+					Optional:            true,
+					Computed:            true,
+				},
+			}
+		},
+		newModel: func() typedMonitorModel { return &MonitorPingResourceModel{} },
+	}
+}
+
+// MonitorPingResourceModel describes the uptimekuma_monitor_ping data model.
+type MonitorPingResourceModel struct {
+	MonitorBaseModel
+	Hostname              types.String  `tfsdk:"hostname"`
+	PingCount             types.Int64   `tfsdk:"ping_count"`
+	PingNumeric           types.Bool    `tfsdk:"ping_numeric"`
+	PingPerRequestTimeout types.Int64   `tfsdk:"ping_per_request_timeout"`
+	Timeout               types.Float64 `tfsdk:"timeout"`
+}
+
+func (m *MonitorPingResourceModel) base() *MonitorBaseModel {
+	return &m.MonitorBaseModel
+}
+
+func (m *MonitorPingResourceModel) toMonitor(ctx context.Context) (kumamonitor.Monitor, error) {
+	return &kumamonitor.Ping{
+		Base: m.kumaBase(ctx),
+		PingDetails: kumamonitor.PingDetails{
+			Hostname:              m.Hostname.ValueString(),
+			PingCount:             int(m.PingCount.ValueInt64()),
+			PingNumeric:           m.PingNumeric.ValueBool(),
+			PingPerRequestTimeout: int(m.PingPerRequestTimeout.ValueInt64()),
+			Timeout:               m.Timeout.ValueFloat64(),
+		},
+	}, nil
+}
+
+func (m *MonitorPingResourceModel) fromMonitor(ctx context.Context, b kumamonitor.Base) error {
+	var v kumamonitor.Ping
+	if err := b.As(&v); err != nil {
+		return err
+	}
+
+	m.setFromKuma(ctx, v.Base)
+	m.Hostname = stringValueOrNull(v.Hostname)
+	m.PingCount = types.Int64Value(int64(v.PingCount))
+	m.PingNumeric = types.BoolValue(v.PingNumeric)
+	m.PingPerRequestTimeout = types.Int64Value(int64(v.PingPerRequestTimeout))
+	m.Timeout = types.Float64Value(v.Timeout)
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMonitorPingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorPingResourceConfig("Ping Monitor", "1.1.1.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_ping.test",
+						tfjsonpath.New("hostname"),
+						knownvalue.StringExact("1.1.1.1"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_ping.test",
+						tfjsonpath.New("ping_count"),
+						knownvalue.Int64Exact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_ping.test",
+						tfjsonpath.New("timeout"),
+						knownvalue.Float64Exact(10),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_monitor_ping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMonitorPingResourceConfig("Ping Monitor", "8.8.8.8"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_ping.test",
+						tfjsonpath.New("hostname"),
+						knownvalue.StringExact("8.8.8.8"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorPingResourceConfig(name string, hostname string) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_ping" "test" {
+  name       = %[4]q
+  hostname   = %[5]q
+  interval   = 60
+  ping_count = 3
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		name, hostname)
+}
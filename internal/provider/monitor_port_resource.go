@@ -0,0 +1,73 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func NewMonitorPortResource() resource.Resource {
+	return &typedMonitorResource{
+		monitorType:    "port",
+		typeNameSuffix: "_monitor_port",
+		description:    "Uptime Kuma TCP port monitor resource. Checks that a TCP connection to a host and port can be established.",
+		attributes: func() map[string]schema.Attribute {
+			return map[string]schema.Attribute{
+				"hostname": schema.StringAttribute{
+					MarkdownDescription: "Hostname or IP address to connect to",
+					Required:            true,
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "TCP port to connect to",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+			}
+		},
+		newModel: func() typedMonitorModel { return &MonitorPortResourceModel{} },
+	}
+}
+
+// MonitorPortResourceModel describes the uptimekuma_monitor_port data model.
+type MonitorPortResourceModel struct {
+	MonitorBaseModel
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+}
+
+func (m *MonitorPortResourceModel) base() *MonitorBaseModel {
+	return &m.MonitorBaseModel
+}
+
+func (m *MonitorPortResourceModel) toMonitor(ctx context.Context) (kumamonitor.Monitor, error) {
+	return &kumamonitor.TCPPort{
+		Base: m.kumaBase(ctx),
+		TCPPortDetails: kumamonitor.TCPPortDetails{
+			Hostname: m.Hostname.ValueString(),
+			Port:     int(m.Port.ValueInt64()),
+		},
+	}, nil
+}
+
+func (m *MonitorPortResourceModel) fromMonitor(ctx context.Context, b kumamonitor.Base) error {
+	var v kumamonitor.TCPPort
+	if err := b.As(&v); err != nil {
+		return err
+	}
+
+	m.setFromKuma(ctx, v.Base)
+	m.Hostname = stringValueOrNull(v.Hostname)
+	m.Port = types.Int64Value(int64(v.Port))
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMonitorPortResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMonitorPortResourceConfig("Port Monitor", 443),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_port.test",
+						tfjsonpath.New("hostname"),
+						knownvalue.StringExact("example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_port.test",
+						tfjsonpath.New("port"),
+						knownvalue.Int64Exact(443),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_monitor_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMonitorPortResourceConfig("Port Monitor", 80),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_port.test",
+						tfjsonpath.New("port"),
+						knownvalue.Int64Exact(80),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorPortResourceConfig(name string, port int) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_monitor_port" "test" {
+  name     = %[4]q
+  hostname = "example.com"
+  port     = %[5]d
+  interval = 60
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		name, port)
+}
@@ -5,29 +5,35 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
-	"github.com/breml/go-uptime-kuma-client/tag"
 	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MonitorResource{}
 var _ resource.ResourceWithImportState = &MonitorResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorResource{}
+var _ resource.ResourceWithConfigValidators = &MonitorResource{}
 
 func NewMonitorResource() resource.Resource {
 	return &MonitorResource{}
@@ -40,31 +46,144 @@ type MonitorResource struct {
 
 // MonitorResourceModel describes the resource data model.
 type MonitorResourceModel struct {
-	ID                       types.Int64  `tfsdk:"id"`
-	Type                     types.String `tfsdk:"type"`
-	Name                     types.String `tfsdk:"name"`
-	Active                   types.Bool   `tfsdk:"active"`
-	URL                      types.String `tfsdk:"url"`
-	Method                   types.String `tfsdk:"method"`
-	Hostname                 types.String `tfsdk:"hostname"`
-	Port                     types.Int64  `tfsdk:"port"`
-	Interval                 types.Int64  `tfsdk:"interval"`
-	RetryInterval            types.Int64  `tfsdk:"retry_interval"`
-	ResendInterval           types.Int64  `tfsdk:"resend_interval"`
-	MaxRetries               types.Int64  `tfsdk:"max_retries"`
-	UpsideDown               types.Bool   `tfsdk:"upside_down"`
-	IgnoreTLS                types.Bool   `tfsdk:"ignore_tls"`
-	MaxRedirects             types.Int64  `tfsdk:"max_redirects"`
-	Body                     types.String `tfsdk:"body"`
-	Headers                  types.String `tfsdk:"headers"`
-	AuthMethod               types.String `tfsdk:"auth_method"`
-	BasicAuthUser            types.String `tfsdk:"basic_auth_user"`
-	BasicAuthPass            types.String `tfsdk:"basic_auth_pass"`
-	Keyword                  types.String `tfsdk:"keyword"`
-	NotificationIDList       types.List   `tfsdk:"notification_id_list"`
-	AcceptedStatusCodes      types.List   `tfsdk:"accepted_status_codes"`
-	DatabaseConnectionString types.String `tfsdk:"database_connection_string"`
-	Tags                     types.List   `tfsdk:"tags"`
+	MonitorBaseModel
+	monitorHTTPModel
+	Type                     types.String                `tfsdk:"type"`
+	Hostname                 types.String                `tfsdk:"hostname"`
+	Port                     types.Int64                 `tfsdk:"port"`
+	PingCount                types.Int64                 `tfsdk:"ping_count"`
+	PingNumeric              types.Bool                  `tfsdk:"ping_numeric"`
+	PingPerRequestTimeout    types.Int64                 `tfsdk:"ping_per_request_timeout"`
+	Keyword                  types.String                `tfsdk:"keyword"`
+	InvertKeyword            types.Bool                  `tfsdk:"invert_keyword"`
+	DatabaseConnectionString types.String                `tfsdk:"database_connection_string"`
+	MQTTTopic                types.String                `tfsdk:"mqtt_topic"`
+	MQTTUsername             types.String                `tfsdk:"mqtt_username"`
+	MQTTPassword             types.String                `tfsdk:"mqtt_password"`
+	MQTTSuccessMessage       types.String                `tfsdk:"mqtt_success_message"`
+	MQTTCheckType            types.String                `tfsdk:"mqtt_check_type"`
+	GRPCProtobuf             WhitespaceInsensitiveString `tfsdk:"grpc_protobuf"`
+	GRPCServiceName          types.String                `tfsdk:"grpc_service_name"`
+	GRPCMethod               types.String                `tfsdk:"grpc_method"`
+	GRPCBody                 types.String                `tfsdk:"grpc_body"`
+	GRPCEnableTLS            types.Bool                  `tfsdk:"grpc_enable_tls"`
+	RemoteBrowserID          types.Int64                 `tfsdk:"remote_browser_id"`
+	KafkaProducerBrokers     types.List                  `tfsdk:"kafka_producer_brokers"`
+	KafkaProducerTopic       types.String                `tfsdk:"kafka_producer_topic"`
+	KafkaProducerMessage     types.String                `tfsdk:"kafka_producer_message"`
+	KafkaProducerSSL         types.Bool                  `tfsdk:"kafka_producer_ssl"`
+	KafkaProducerSASLOptions types.Object                `tfsdk:"kafka_producer_sasl_options"`
+	RadiusUsername           types.String                `tfsdk:"radius_username"`
+	RadiusPassword           types.String                `tfsdk:"radius_password"`
+	RadiusSecret             types.String                `tfsdk:"radius_secret"`
+	RadiusCalledStationID    types.String                `tfsdk:"radius_called_station_id"`
+	RadiusCallingStationID   types.String                `tfsdk:"radius_calling_station_id"`
+	Game                     types.String                `tfsdk:"game"`
+	GameDigGivenPortOnly     types.Bool                  `tfsdk:"gamedig_given_port_only"`
+	SNMPVersion              types.String                `tfsdk:"snmp_version"`
+	SNMPCommunityString      types.String                `tfsdk:"snmp_community_string"`
+	SNMPOID                  types.String                `tfsdk:"snmp_oid"`
+	SNMPV3Username           types.String                `tfsdk:"snmp_v3_username"`
+	SNMPV3AuthPassword       types.String                `tfsdk:"snmp_v3_auth_password"`
+	SNMPV3PrivacyPassword    types.String                `tfsdk:"snmp_v3_privacy_password"`
+	JSONPath                 types.String                `tfsdk:"json_path"`
+	JSONPathOperator         types.String                `tfsdk:"json_path_operator"`
+	ExpectedValue            types.String                `tfsdk:"expected_value"`
+	RabbitMQNodes            types.List                  `tfsdk:"rabbitmq_nodes"`
+	RabbitMQUsername         types.String                `tfsdk:"rabbitmq_username"`
+	RabbitMQPassword         types.String                `tfsdk:"rabbitmq_password"`
+	ManualStatus             types.String                `tfsdk:"manual_status"`
+	RawConfig                JSONObjectString            `tfsdk:"raw_config"`
+	Conditions               types.List                  `tfsdk:"conditions"`
+}
+
+// Ports Uptime Kuma uses for radius and snmp monitors when none is set.
+const (
+	defaultRadiusPort = 1812
+	defaultSNMPPort   = 161
+)
+
+// defaultHTTPBodyEncoding is the request body encoding Uptime Kuma uses when
+// none is configured.
+const defaultHTTPBodyEncoding = "json"
+
+// oauthAuthMethodSecretBasic is how Uptime Kuma sends oauth2-cc client
+// credentials when no oauth_auth_method is configured.
+const oauthAuthMethodSecretBasic = "client_secret_basic"
+
+// defaultSNMPJSONPath selects the whole SNMP response value.
+const defaultSNMPJSONPath = "$"
+
+// snmpVersions maps the snmp_version attribute values to the values stored by Uptime Kuma.
+var snmpVersions = map[string]string{
+	"v1":  "1",
+	"v2c": "2c",
+	"v3":  "3",
+}
+
+// manualStatuses maps the manual_status attribute values to the heartbeat
+// status codes Uptime Kuma uses.
+var manualStatuses = map[string]int{
+	"down":        0,
+	"up":          1,
+	"pending":     2,
+	"maintenance": 3,
+}
+
+// Bounds and defaults of the ping monitor options enforced by Uptime Kuma.
+// Timeouts are in seconds.
+const (
+	minPingCount                 = 1
+	maxPingCount                 = 100
+	defaultPingCount             = 1
+	minPingPerRequestTimeout     = 1
+	maxPingPerRequestTimeout     = 60
+	defaultPingPerRequestTimeout = 2
+	minPingTimeout               = 1
+	maxPingTimeout               = 300
+	defaultPingTimeout           = 10
+)
+
+// KafkaProducerSASLOptionsModel describes the SASL options of a kafka-producer monitor.
+type KafkaProducerSASLOptionsModel struct {
+	Mechanism             types.String `tfsdk:"mechanism"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	AuthorizationIdentity types.String `tfsdk:"authorization_identity"`
+	AccessKeyID           types.String `tfsdk:"access_key_id"`
+	SecretAccessKey       types.String `tfsdk:"secret_access_key"`
+	SessionToken          types.String `tfsdk:"session_token"`
+}
+
+// kafkaProducerSASLOptionsAttrTypes returns the attribute types of kafka_producer_sasl_options.
+func kafkaProducerSASLOptionsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mechanism":              types.StringType,
+		"username":               types.StringType,
+		"password":               types.StringType,
+		"authorization_identity": types.StringType,
+		"access_key_id":          types.StringType,
+		"secret_access_key":      types.StringType,
+		"session_token":          types.StringType,
+	}
+}
+
+// stringValueOrNull maps empty strings returned by Uptime Kuma to null.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// secretValueOrPrior maps a secret returned by Uptime Kuma to its attribute
+// value. Secrets are not always included in the server response, so an empty
+// secret keeps the prior value.
+func secretValueOrPrior(s string, prior types.String) types.String {
+	if s == "" {
+		return prior
+	}
+	return types.StringValue(s)
 }
 
 func (r *MonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,148 +191,392 @@ func (r *MonitorResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Uptime Kuma Monitor resource",
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Computed:            true,
-				MarkdownDescription: "Monitor identifier",
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
-				},
-			},
-			"type": schema.StringAttribute{
-				MarkdownDescription: "Monitor type (http, ping, port, keyword, dns, etc.)",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Monitor name",
-				Required:            true,
-			},
-			"active": schema.BoolAttribute{
-				MarkdownDescription: "Whether the monitor is active (enabled). Defaults to true.",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(true),
-			},
-			"url": schema.StringAttribute{
-				MarkdownDescription: "URL to monitor (required for http, keyword monitors)",
-				Optional:            true,
-			},
-			"method": schema.StringAttribute{
-				MarkdownDescription: "HTTP method (GET, POST, etc.) for http monitors",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString("GET"),
-			},
-			"hostname": schema.StringAttribute{
-				MarkdownDescription: "Hostname for ping, port, etc. monitors. Also used for database connection strings.",
-				Optional:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port number for port monitors",
-				Optional:            true,
-			},
-			"interval": schema.Int64Attribute{
-				MarkdownDescription: "Check interval in seconds",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(60),
-			},
-			"retry_interval": schema.Int64Attribute{
-				MarkdownDescription: "Retry interval in seconds",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(60),
+	attributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Monitor type (http, ping, port, keyword, mqtt, grpc-keyword, real-browser, kafka-producer, radius, gamedig, steam, snmp, rabbitmq, tailscale-ping, manual, etc.)",
+			Required:            true,
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "URL to monitor (required for http, keyword, grpc-keyword and real-browser monitors)",
+			Optional:            true,
+		},
+		"method": schema.StringAttribute{
+			MarkdownDescription: "HTTP method (GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS) for http and keyword monitors. Defaults to GET.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"),
 			},
-			"resend_interval": schema.Int64Attribute{
-				MarkdownDescription: "Notification resend interval in seconds",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(0),
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "Hostname for ping, port, gamedig, steam, snmp, tailscale-ping, etc. monitors. Also used for database connection strings.",
+			Optional:            true,
+		},
+		"port": schema.Int64Attribute{
+			MarkdownDescription: "Port number for port, mqtt, radius, gamedig, steam and snmp monitors. Defaults to 1812 for radius and 161 for snmp monitors.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"ignore_tls": schema.BoolAttribute{
+			MarkdownDescription: "Ignore TLS/SSL errors of http and keyword monitors. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"timeout": schema.Float64Attribute{
+			MarkdownDescription: "Request timeout in seconds for http and keyword monitors, and overall timeout (1 to 300) for ping monitors. Defaults to 80% of `interval` for http and keyword monitors, like the Uptime Kuma UI, and to 10 for ping monitors.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
 			},
-			"max_retries": schema.Int64Attribute{
-				MarkdownDescription: "Maximum number of retries",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(0),
+		},
+		"ping_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of packets sent per check for ping monitors (1 to 100). Defaults to 1.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(minPingCount, maxPingCount),
 			},
-			"upside_down": schema.BoolAttribute{
-				MarkdownDescription: "Invert status (treat DOWN as UP and vice versa)",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
+		},
+		"ping_numeric": schema.BoolAttribute{
+			MarkdownDescription: "Output numeric addresses only, skipping reverse DNS lookups, for ping monitors. Defaults to true.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"ping_per_request_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Timeout in seconds of each packet for ping monitors (1 to 60). Defaults to 2.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(minPingPerRequestTimeout, maxPingPerRequestTimeout),
 			},
-			"ignore_tls": schema.BoolAttribute{
-				MarkdownDescription: "Ignore TLS/SSL errors",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
+		},
+		"http_body_encoding": schema.StringAttribute{
+			MarkdownDescription: "Encoding of the request body for http and keyword monitors (json, xml, form). Defaults to json.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(defaultHTTPBodyEncoding, "xml", "form"),
 			},
-			"max_redirects": schema.Int64Attribute{
-				MarkdownDescription: "Maximum number of redirects to follow",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(0),
+		},
+		"cache_bust": schema.BoolAttribute{
+			MarkdownDescription: "Add a random query parameter to each request of http and keyword monitors to bypass caches. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"expiry_notification": schema.BoolAttribute{
+			MarkdownDescription: "Send a notification before the TLS certificate of the URL of http and keyword monitors expires. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"proxy_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of an `uptimekuma_proxy` to send the requests of http and keyword monitors through. Removing it detaches the monitor from the proxy.",
+			Optional:            true,
+		},
+		"max_redirects": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of redirects to follow for http and keyword monitors. Defaults to 0.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"body": schema.StringAttribute{
+			MarkdownDescription: "Request body for http monitors",
+			Optional:            true,
+		},
+		"headers": schema.StringAttribute{
+			MarkdownDescription: "Request headers for http and keyword monitors as a JSON object. Formatting and key order differences are ignored. Conflicts with `request_headers` and `sensitive_headers`.",
+			CustomType:          JSONObjectStringType{},
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("request_headers"), path.MatchRoot("sensitive_headers")),
 			},
-			"body": schema.StringAttribute{
-				MarkdownDescription: "Request body for http monitors",
-				Optional:            true,
+		},
+		"request_headers": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Request headers for http and keyword monitors, by header name",
+			Optional:            true,
+		},
+		"sensitive_headers": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Request headers for http and keyword monitors whose values are hidden in plans, such as authorization tokens. Sent together with `request_headers`.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"keyword": schema.StringAttribute{
+			MarkdownDescription: "Keyword to search for in response",
+			Optional:            true,
+		},
+		"invert_keyword": schema.BoolAttribute{
+			MarkdownDescription: "Mark keyword, grpc-keyword and real-browser monitors as DOWN when the keyword is found instead of when it is missing. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"accepted_status_codes": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Accepted HTTP status codes for http, keyword and real-browser monitors, as single codes or ranges (e.g., [\"200-299\", \"304\"]). Defaults to [\"200-299\"].",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(acceptedStatusCodeValidator()),
 			},
-			"headers": schema.StringAttribute{
-				MarkdownDescription: "Request headers for http monitors (JSON format)",
-				Optional:            true,
+		},
+		"database_connection_string": schema.StringAttribute{
+			MarkdownDescription: "Database connection string for database monitors (postgres, mysql, mongodb, etc.)",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"mqtt_topic": schema.StringAttribute{
+			MarkdownDescription: "MQTT topic to subscribe to for mqtt monitors",
+			Optional:            true,
+		},
+		"mqtt_username": schema.StringAttribute{
+			MarkdownDescription: "MQTT broker username for mqtt monitors",
+			Optional:            true,
+		},
+		"mqtt_password": schema.StringAttribute{
+			MarkdownDescription: "MQTT broker password for mqtt monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"mqtt_success_message": schema.StringAttribute{
+			MarkdownDescription: "Expected message (keyword) or value (json-query) received on the topic for mqtt monitors",
+			Optional:            true,
+		},
+		"mqtt_check_type": schema.StringAttribute{
+			MarkdownDescription: "How the received message is checked for mqtt monitors (keyword, json-query). Defaults to keyword.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("keyword", "json-query"),
 			},
-			"auth_method": schema.StringAttribute{
-				MarkdownDescription: "Authentication method (basic, ntlm, mtls)",
-				Optional:            true,
+		},
+		"grpc_protobuf": schema.StringAttribute{
+			CustomType:          WhitespaceInsensitiveStringType{},
+			MarkdownDescription: "Protobuf definition of the service for grpc-keyword monitors, typically loaded with `file()`. Differences in whitespace only are not treated as drift.",
+			Optional:            true,
+		},
+		"grpc_service_name": schema.StringAttribute{
+			MarkdownDescription: "Fully qualified gRPC service name for grpc-keyword monitors",
+			Optional:            true,
+		},
+		"grpc_method": schema.StringAttribute{
+			MarkdownDescription: "gRPC method to call for grpc-keyword monitors",
+			Optional:            true,
+		},
+		"grpc_body": schema.StringAttribute{
+			MarkdownDescription: "Request body (JSON) sent to the gRPC method for grpc-keyword monitors",
+			Optional:            true,
+		},
+		"grpc_enable_tls": schema.BoolAttribute{
+			MarkdownDescription: "Use TLS when connecting to the gRPC server for grpc-keyword monitors. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"remote_browser_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the `uptimekuma_remote_browser` used by real-browser monitors. The browser bundled with Uptime Kuma is used when omitted.",
+			Optional:            true,
+		},
+		"kafka_producer_brokers": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Kafka brokers as `host:port` for kafka-producer monitors, with IPv6 addresses in brackets",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(\[[0-9A-Fa-f:.]+\]|[^\s:\[\]]+):[0-9]{1,5}$`),
+						"must be a broker address in host:port format, with IPv6 addresses in brackets (e.g., kafka-1:9092 or [::1]:9092)",
+					),
+				),
 			},
-			"basic_auth_user": schema.StringAttribute{
-				MarkdownDescription: "Basic auth username",
-				Optional:            true,
+		},
+		"kafka_producer_topic": schema.StringAttribute{
+			MarkdownDescription: "Topic to produce to for kafka-producer monitors",
+			Optional:            true,
+		},
+		"kafka_producer_message": schema.StringAttribute{
+			MarkdownDescription: "Message to produce for kafka-producer monitors",
+			Optional:            true,
+		},
+		"kafka_producer_ssl": schema.BoolAttribute{
+			MarkdownDescription: "Connect to the Kafka brokers using SSL for kafka-producer monitors. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"kafka_producer_sasl_options": schema.SingleNestedAttribute{
+			MarkdownDescription: "SASL authentication for kafka-producer monitors. No SASL authentication is used when omitted.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"mechanism": schema.StringAttribute{
+					MarkdownDescription: "SASL mechanism (plain, scram-sha-256, scram-sha-512, aws)",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("plain", "scram-sha-256", "scram-sha-512", "aws"),
+					},
+				},
+				"username": schema.StringAttribute{
+					MarkdownDescription: "SASL username (plain, scram-sha-256, scram-sha-512)",
+					Optional:            true,
+				},
+				"password": schema.StringAttribute{
+					MarkdownDescription: "SASL password (plain, scram-sha-256, scram-sha-512)",
+					Optional:            true,
+					Sensitive:           true,
+				},
+				"authorization_identity": schema.StringAttribute{
+					MarkdownDescription: "AWS IAM authorization identity (aws)",
+					Optional:            true,
+				},
+				"access_key_id": schema.StringAttribute{
+					MarkdownDescription: "AWS access key ID (aws)",
+					Optional:            true,
+				},
+				"secret_access_key": schema.StringAttribute{
+					MarkdownDescription: "AWS secret access key (aws)",
+					Optional:            true,
+					Sensitive:           true,
+				},
+				"session_token": schema.StringAttribute{
+					MarkdownDescription: "AWS session token (aws)",
+					Optional:            true,
+					Sensitive:           true,
+				},
 			},
-			"basic_auth_pass": schema.StringAttribute{
-				MarkdownDescription: "Basic auth password",
-				Optional:            true,
-				Sensitive:           true,
+		},
+		"radius_username": schema.StringAttribute{
+			MarkdownDescription: "Username used to log in for radius monitors",
+			Optional:            true,
+		},
+		"radius_password": schema.StringAttribute{
+			MarkdownDescription: "Password used to log in for radius monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"radius_secret": schema.StringAttribute{
+			MarkdownDescription: "Shared secret between Uptime Kuma and the RADIUS server for radius monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"radius_called_station_id": schema.StringAttribute{
+			MarkdownDescription: "Called-Station-Id sent in the access request for radius monitors",
+			Optional:            true,
+		},
+		"radius_calling_station_id": schema.StringAttribute{
+			MarkdownDescription: "Calling-Station-Id sent in the access request for radius monitors",
+			Optional:            true,
+		},
+		"game": schema.StringAttribute{
+			MarkdownDescription: "GameDig game identifier (e.g., minecraft, csgo, valheim) for gamedig monitors",
+			Optional:            true,
+			Validators: []validator.String{
+				gamedigGameValidator{},
 			},
-			"keyword": schema.StringAttribute{
-				MarkdownDescription: "Keyword to search for in response",
-				Optional:            true,
+		},
+		"gamedig_given_port_only": schema.BoolAttribute{
+			MarkdownDescription: "Only query the given port instead of letting GameDig probe the game's known query ports. Defaults to true.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"snmp_version": schema.StringAttribute{
+			MarkdownDescription: "SNMP protocol version (v1, v2c, v3) for snmp monitors. Defaults to v2c.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("v1", "v2c", "v3"),
 			},
-			"notification_id_list": schema.ListAttribute{
-				ElementType:         types.Int64Type,
-				MarkdownDescription: "List of notification IDs to trigger when monitor status changes",
-				Optional:            true,
+		},
+		"snmp_community_string": schema.StringAttribute{
+			MarkdownDescription: "Community string for v1 and v2c snmp monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"snmp_oid": schema.StringAttribute{
+			MarkdownDescription: "Numeric object identifier to query for snmp monitors (e.g., 1.3.6.1.2.1.1.3.0)",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^\.?[0-2](\.(0|[1-9][0-9]*))+$`),
+					"must be a numeric OID in dotted notation (e.g., 1.3.6.1.2.1.1.3.0)",
+				),
 			},
-			"accepted_status_codes": schema.ListAttribute{
-				ElementType:         types.Int64Type,
-				MarkdownDescription: "List of accepted HTTP status codes (e.g., [200, 201, 204]). Defaults to all 2xx codes if not specified.",
-				Optional:            true,
+		},
+		"snmp_v3_username": schema.StringAttribute{
+			MarkdownDescription: "Security name for v3 snmp monitors",
+			Optional:            true,
+		},
+		"snmp_v3_auth_password": schema.StringAttribute{
+			MarkdownDescription: "Authentication passphrase for v3 snmp monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"snmp_v3_privacy_password": schema.StringAttribute{
+			MarkdownDescription: "Privacy (encryption) passphrase for v3 snmp monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"json_path": schema.StringAttribute{
+			MarkdownDescription: "JSON path applied to the response before comparing it with `expected_value` (snmp monitors). Defaults to `$`, the whole value.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"json_path_operator": schema.StringAttribute{
+			MarkdownDescription: "Operator used to compare the `json_path` result with `expected_value` (==, !=, <, <=, >, >=, contains)",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("==", "!=", "<", "<=", ">", ">=", "contains"),
 			},
-			"database_connection_string": schema.StringAttribute{
-				MarkdownDescription: "Database connection string for database monitors (postgres, mysql, mongodb, etc.)",
-				Optional:            true,
-				Sensitive:           true,
+		},
+		"expected_value": schema.StringAttribute{
+			MarkdownDescription: "Value the `json_path` result is compared with",
+			Optional:            true,
+		},
+		"rabbitmq_nodes": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Management API URLs of the cluster nodes (e.g., https://rabbitmq-1:15672) for rabbitmq monitors",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https?://\S+$`),
+						"must be an http or https URL of a management node (e.g., https://rabbitmq-1:15672)",
+					),
+				),
 			},
-			"tags": schema.ListNestedAttribute{
-				MarkdownDescription: "Tags associated with the monitor",
-				Optional:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"tag_id": schema.Int64Attribute{
-							MarkdownDescription: "Tag ID",
-							Required:            true,
-						},
-						"value": schema.StringAttribute{
-							MarkdownDescription: "Value for the tag",
-							Optional:            true,
-						},
-					},
-				},
+		},
+		"rabbitmq_username": schema.StringAttribute{
+			MarkdownDescription: "Management API username for rabbitmq monitors",
+			Optional:            true,
+		},
+		"rabbitmq_password": schema.StringAttribute{
+			MarkdownDescription: "Management API password for rabbitmq monitors",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"manual_status": schema.StringAttribute{
+			MarkdownDescription: "Status reported by manual monitors (up, down, maintenance, pending). Defaults to up. Changing it updates the monitor in place.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("up", "down", "maintenance", "pending"),
 			},
 		},
+		"conditions": monitorConditionsSchemaAttribute(),
+		"raw_config": schema.StringAttribute{
+			CustomType: JSONObjectStringType{},
+			MarkdownDescription: "Type-specific monitor fields as a JSON object, sent to Uptime Kuma as-is. " +
+				"Only for monitor types without dedicated attributes in this provider. " +
+				"Only the configured keys are read back, so drift on them is detected while other server-side fields are ignored.",
+			Optional: true,
+		},
+	}
+	// The authentication attributes are shared with the http and keyword monitor resources
+	maps.Copy(attributes, monitorHTTPAuthSchemaAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uptime Kuma Monitor resource",
+		Version:             monitorSchemaVersion,
+
+		Attributes: withMonitorBaseAttributes(attributes),
 	}
 }
 
@@ -237,6 +600,219 @@ func (r *MonitorResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *MonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		monitorTypeAttributesValidator{},
+		monitorAuthMethodAttributesValidator{},
+		monitorHeadersValidator{},
+		monitorConditionsValidator{},
+		monitorPingTimeoutValidator{},
+	}
+}
+
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The monitor_defaults of the provider apply to unset attributes, and
+	// before the timeout, which depends on the interval
+	resp.Diagnostics.Append(planMonitorDefaults(ctx, r.client, req.Config, &resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a configured timeout, http and keyword monitors time out at 80%
+	// of the interval. Plan that value so the server default does not show as
+	// drift, and recompute it when the interval changes.
+	var monitorType types.String
+	var interval types.Int64
+	var configTimeout types.Float64
+	var configStatusCodes types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("interval"), &interval)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &configTimeout)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("accepted_status_codes"), &configStatusCodes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configTimeout.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("timeout"), defaultMonitorTimeout(monitorType, interval))...)
+	}
+
+	// Likewise, Uptime Kuma accepts 200-299 when no status codes are configured,
+	// unless the provider sets other defaults
+	if configStatusCodes.IsNull() {
+		var defaultCodes []string
+		if r.client != nil {
+			defaultCodes = r.client.MonitorDefaults.AcceptedStatusCodes
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("accepted_status_codes"), defaultMonitorAcceptedStatusCodes(ctx, monitorType, defaultCodes))...)
+	}
+
+	// Plan the values Uptime Kuma stores for unset attributes with a per type
+	// default, so that the stored value is read back without drift
+	for name := range monitorTypeDefaultAttributes {
+		var configValue attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		if configValue == nil || !configValue.IsNull() {
+			continue
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), defaultMonitorTypeAttribute(monitorType, name))...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The remaining checks need the provider to be configured
+	if r.client == nil {
+		return
+	}
+
+	// Plan the IDs of the tags referenced by name and the default tags
+	resp.Diagnostics.Append(planMonitorTags(ctx, r.client, &resp.Plan)...)
+
+	// Steam monitors query the Steam Web API, which only works when a Steam API
+	// key is configured in the Uptime Kuma settings. Warn on create so the
+	// monitor does not silently stay DOWN.
+	if req.State.Raw.IsNull() && monitorType.ValueString() == "steam" {
+		settings, err := r.client.Kuma.GetSettings(ctx)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Unable to read settings to check for a Steam API key: %s", err))
+		} else if settings.SteamAPIKey == "" {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("type"),
+				"Steam API Key Not Configured",
+				"Steam monitors require a Steam API key, but none is configured in the Uptime Kuma settings. "+
+					"The monitor will be created but will report DOWN until a key is set under Settings > General.",
+			)
+		}
+	}
+}
+
+// defaultMonitorTimeout returns the timeout Uptime Kuma uses for a monitor
+// without a configured timeout: 80% of the interval for http and keyword
+// monitors, a fixed timeout for ping monitors, and none for other monitor
+// types.
+func defaultMonitorTimeout(monitorType types.String, interval types.Int64) types.Float64 {
+	if monitorType.IsUnknown() {
+		return types.Float64Unknown()
+	}
+	if !monitorTypeSupports(monitorType.ValueString(), "timeout") {
+		return types.Float64Null()
+	}
+	if monitorType.ValueString() == "ping" {
+		return types.Float64Value(defaultPingTimeout)
+	}
+	if interval.IsUnknown() {
+		return types.Float64Unknown()
+	}
+	return types.Float64Value(float64(interval.ValueInt64()*8) / 10)
+}
+
+// monitorTypeDefaultAttributes lists the attributes with a per type default
+// in monitorTypeDefaults, with their null and unknown values.
+var monitorTypeDefaultAttributes = map[string][2]attr.Value{
+	"method":                   {types.StringNull(), types.StringUnknown()},
+	"ignore_tls":               {types.BoolNull(), types.BoolUnknown()},
+	"max_redirects":            {types.Int64Null(), types.Int64Unknown()},
+	"cache_bust":               {types.BoolNull(), types.BoolUnknown()},
+	"expiry_notification":      {types.BoolNull(), types.BoolUnknown()},
+	"invert_keyword":           {types.BoolNull(), types.BoolUnknown()},
+	"port":                     {types.Int64Null(), types.Int64Unknown()},
+	"ping_count":               {types.Int64Null(), types.Int64Unknown()},
+	"ping_numeric":             {types.BoolNull(), types.BoolUnknown()},
+	"ping_per_request_timeout": {types.Int64Null(), types.Int64Unknown()},
+	"mqtt_check_type":          {types.StringNull(), types.StringUnknown()},
+	"grpc_enable_tls":          {types.BoolNull(), types.BoolUnknown()},
+	"kafka_producer_ssl":       {types.BoolNull(), types.BoolUnknown()},
+	"gamedig_given_port_only":  {types.BoolNull(), types.BoolUnknown()},
+	"snmp_version":             {types.StringNull(), types.StringUnknown()},
+	"json_path":                {types.StringNull(), types.StringUnknown()},
+	"manual_status":            {types.StringNull(), types.StringUnknown()},
+}
+
+// monitorTypeDefaults holds the values Uptime Kuma stores for unset
+// attributes, by monitor type. Attributes of other monitor types stay null.
+var monitorTypeDefaults = map[string]map[string]attr.Value{
+	"http": {
+		"method":              types.StringValue("GET"),
+		"ignore_tls":          types.BoolValue(false),
+		"max_redirects":       types.Int64Value(0),
+		"cache_bust":          types.BoolValue(false),
+		"expiry_notification": types.BoolValue(false),
+	},
+	"keyword": {
+		"method":              types.StringValue("GET"),
+		"ignore_tls":          types.BoolValue(false),
+		"max_redirects":       types.Int64Value(0),
+		"cache_bust":          types.BoolValue(false),
+		"expiry_notification": types.BoolValue(false),
+		"invert_keyword":      types.BoolValue(false),
+	},
+	"ping": {
+		"ping_count":               types.Int64Value(defaultPingCount),
+		"ping_numeric":             types.BoolValue(true),
+		"ping_per_request_timeout": types.Int64Value(defaultPingPerRequestTimeout),
+	},
+	"mqtt": {
+		"mqtt_check_type": types.StringValue("keyword"),
+	},
+	"grpc-keyword": {
+		"grpc_enable_tls": types.BoolValue(false),
+		"invert_keyword":  types.BoolValue(false),
+	},
+	"real-browser": {
+		"invert_keyword": types.BoolValue(false),
+	},
+	"kafka-producer": {
+		"kafka_producer_ssl": types.BoolValue(false),
+	},
+	"radius": {
+		"port": types.Int64Value(defaultRadiusPort),
+	},
+	"gamedig": {
+		"gamedig_given_port_only": types.BoolValue(true),
+	},
+	"snmp": {
+		"port":         types.Int64Value(defaultSNMPPort),
+		"snmp_version": types.StringValue("v2c"),
+		"json_path":    types.StringValue(defaultSNMPJSONPath),
+	},
+	"manual": {
+		"manual_status": types.StringValue("up"),
+	},
+}
+
+// defaultMonitorTypeAttribute returns the value Uptime Kuma stores for an
+// unset attribute of a monitor type, or null when the type has no default
+// for it.
+func defaultMonitorTypeAttribute(monitorType types.String, name string) attr.Value {
+	values := monitorTypeDefaultAttributes[name]
+	if monitorType.IsUnknown() {
+		return values[1]
+	}
+	if value, ok := monitorTypeDefaults[monitorType.ValueString()][name]; ok {
+		return value
+	}
+	return values[0]
+}
+
+// defaultMonitorAcceptedStatusCodes returns the accepted status codes for a
+// monitor without configured status codes: the given defaults, or the ones
+// Uptime Kuma uses without defaults. Monitor types that do not check a status
+// code have none.
+func defaultMonitorAcceptedStatusCodes(ctx context.Context, monitorType types.String, defaults []string) types.Set {
+	if monitorType.IsUnknown() {
+		return types.SetUnknown(types.StringType)
+	}
+	if !monitorTypeSupports(monitorType.ValueString(), "accepted_status_codes") {
+		return types.SetNull(types.StringType)
+	}
+	return acceptedStatusCodesValue(ctx, defaults)
+}
+
 func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data MonitorResourceModel
 
@@ -247,6 +823,12 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// Resolve the tags referenced by name, creating the missing ones if allowed
+	resp.Diagnostics.Append(resolvePlannedMonitorTags(ctx, r.client, &data.MonitorBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	monitor, err := r.monitorFromPlan(ctx, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating monitor", err.Error())
@@ -267,33 +849,16 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 	// Handle active state (monitors are created active by default, pause if active=false)
 	// The active field in the API create request is not reliable, so we use PauseMonitor/ResumeMonitor
 	if !data.Active.ValueBool() {
-		if err := r.client.Kuma.PauseMonitor(ctx, id); err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pause monitor %d: %s", id, err))
+		if err := setMonitorActive(ctx, r.client, id, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set active state: %s", err))
 			return
 		}
 	}
 
 	// Add tags to the monitor (tags are managed separately via AddMonitorTag API)
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		type tagModel struct {
-			TagID types.Int64  `tfsdk:"tag_id"`
-			Value types.String `tfsdk:"value"`
-		}
-		var tfTags []tagModel
-		data.Tags.ElementsAs(ctx, &tfTags, false)
-
-		for _, t := range tfTags {
-			tagID := t.TagID.ValueInt64()
-			value := ""
-			if !t.Value.IsNull() {
-				value = t.Value.ValueString()
-			}
-			_, err := r.client.Kuma.AddMonitorTag(ctx, tagID, id, value)
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add tag %d to monitor %d: %s", tagID, id, err))
-				return
-			}
-		}
+	if err := createMonitorTags(ctx, r.client, id, data.TagsAll); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set tags: %s", err))
+		return
 	}
 
 	// Save data into Terraform state
@@ -384,13 +949,92 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 			return
 		}
 		fullMonitor = &m
+	case "mqtt":
+		var m kumamonitor.MQTT
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "grpc-keyword":
+		var m kumamonitor.GRPCKeyword
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "real-browser":
+		var m kumamonitor.RealBrowser
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "kafka-producer":
+		var m kumamonitor.KafkaProducer
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "radius":
+		var m kumamonitor.Radius
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "gamedig":
+		var m kumamonitor.GameDig
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "steam":
+		var m kumamonitor.Steam
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "snmp":
+		var m kumamonitor.SNMP
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "rabbitmq":
+		var m kumamonitor.RabbitMQ
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "tailscale-ping":
+		var m kumamonitor.TailscalePing
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
+	case "manual":
+		var m kumamonitor.Manual
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
 	default:
-		// Fallback to base if type unknown, but we might miss fields
-		// For now, let's error or just use base if possible?
-		// We can't really use base as full monitor interface in monitorToModel because of casting.
-		// We'll log a warning?
-		tflog.Warn(ctx, fmt.Sprintf("Unsupported monitor type found on read: %s", monitorType))
-		// Use empty struct to avoid nil panic maybe?
+		// Fallback to base if type unknown: keep all fields so the keys
+		// configured in raw_config can be read back
+		var m kumamonitor.Generic
+		if err := baseMonitor.As(&m); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+			return
+		}
+		fullMonitor = &m
 	}
 
 	// Update the data model
@@ -414,6 +1058,12 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Resolve the tags referenced by name, creating the missing ones if allowed
+	resp.Diagnostics.Append(resolvePlannedMonitorTags(ctx, r.client, &data.MonitorBaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	monitor, err := r.monitorFromPlan(ctx, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error preparing monitor update", err.Error())
@@ -423,104 +1073,45 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	idVal := data.ID.ValueInt64()
 	_ = setIdOnMonitor(monitor, idVal) // Error is non-critical, ID will be set if type is known
 
-	if err := r.client.Kuma.UpdateMonitor(ctx, monitor); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor %d: %s", idVal, err))
+	// The prior monitor tells which fields were removed from the configuration
+	prior, err := r.monitorFromPlan(ctx, stateData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error preparing monitor update", fmt.Sprintf("Unable to read the prior state: %s", err))
 		return
 	}
 
-	// Handle tag updates (tags are managed separately via AddMonitorTag/DeleteMonitorTag API)
-	type tagModel struct {
-		TagID types.Int64  `tfsdk:"tag_id"`
-		Value types.String `tfsdk:"value"`
+	// Keep the fields of the stored monitor the provider doesn't manage
+	monitor, err = monitorForUpdate(ctx, r.client, idVal, prior, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
 	}
 
-	// Get current state tags
-	var stateTags []tagModel
-	if !stateData.Tags.IsNull() && !stateData.Tags.IsUnknown() {
-		stateData.Tags.ElementsAs(ctx, &stateTags, false)
+	if err := r.client.Kuma.UpdateMonitor(ctx, monitor); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor %d: %s", idVal, err))
+		return
 	}
 
-	// Get planned tags
-	var planTags []tagModel
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		data.Tags.ElementsAs(ctx, &planTags, false)
+	// Handle tag updates (tags are managed separately via AddMonitorTag/DeleteMonitorTag API)
+	if err := updateMonitorTags(ctx, r.client, idVal, data.TagsAll); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tags: %s", err))
+		return
 	}
 
-	// Build maps for comparison
-	stateTagMap := make(map[int64]string)
-	for _, t := range stateTags {
-		value := ""
-		if !t.Value.IsNull() {
-			value = t.Value.ValueString()
+	// Handle active state changes (requires separate API calls)
+	if data.Active.ValueBool() != stateData.Active.ValueBool() {
+		if err := setMonitorActive(ctx, r.client, idVal, data.Active.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set active state: %s", err))
+			return
 		}
-		stateTagMap[t.TagID.ValueInt64()] = value
 	}
 
-	planTagMap := make(map[int64]string)
-	for _, t := range planTags {
-		value := ""
-		if !t.Value.IsNull() {
-			value = t.Value.ValueString()
-		}
-		planTagMap[t.TagID.ValueInt64()] = value
-	}
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	// Remove tags that are in state but not in plan
-	for tagID, value := range stateTagMap {
-		if _, exists := planTagMap[tagID]; !exists {
-			if err := r.client.Kuma.DeleteMonitorTagWithValue(ctx, tagID, idVal, value); err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove tag %d from monitor %d: %s", tagID, idVal, err))
-				return
-			}
-		}
-	}
-
-	// Add or update tags that are in plan
-	for tagID, planValue := range planTagMap {
-		if stateValue, exists := stateTagMap[tagID]; !exists {
-			// Tag doesn't exist, add it
-			_, err := r.client.Kuma.AddMonitorTag(ctx, tagID, idVal, planValue)
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add tag %d to monitor %d: %s", tagID, idVal, err))
-				return
-			}
-		} else if stateValue != planValue {
-			// Tag exists but value changed, delete old and add new
-			if err := r.client.Kuma.DeleteMonitorTagWithValue(ctx, tagID, idVal, stateValue); err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove old tag value for tag %d from monitor %d: %s", tagID, idVal, err))
-				return
-			}
-			_, err := r.client.Kuma.AddMonitorTag(ctx, tagID, idVal, planValue)
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add tag %d to monitor %d: %s", tagID, idVal, err))
-				return
-			}
-		}
-	}
-
-	// Handle active state changes (requires separate API calls)
-	planActive := data.Active.ValueBool()
-	stateActive := stateData.Active.ValueBool()
-	if planActive != stateActive {
-		if planActive {
-			if err := r.client.Kuma.ResumeMonitor(ctx, idVal); err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resume monitor %d: %s", idVal, err))
-				return
-			}
-		} else {
-			if err := r.client.Kuma.PauseMonitor(ctx, idVal); err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pause monitor %d: %s", idVal, err))
-				return
-			}
-		}
-	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data MonitorResourceModel
+func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MonitorResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -565,6 +1156,28 @@ func setIdOnMonitor(m kumamonitor.Monitor, id int64) error {
 		v.ID = id
 	case *kumamonitor.HTTPKeyword:
 		v.ID = id
+	case *kumamonitor.MQTT:
+		v.ID = id
+	case *kumamonitor.GRPCKeyword:
+		v.ID = id
+	case *kumamonitor.RealBrowser:
+		v.ID = id
+	case *kumamonitor.KafkaProducer:
+		v.ID = id
+	case *kumamonitor.Radius:
+		v.ID = id
+	case *kumamonitor.GameDig:
+		v.ID = id
+	case *kumamonitor.Steam:
+		v.ID = id
+	case *kumamonitor.SNMP:
+		v.ID = id
+	case *kumamonitor.RabbitMQ:
+		v.ID = id
+	case *kumamonitor.TailscalePing:
+		v.ID = id
+	case *kumamonitor.Manual:
+		v.ID = id
 	default:
 		return fmt.Errorf("cannot set ID on unknown type")
 	}
@@ -572,81 +1185,30 @@ func setIdOnMonitor(m kumamonitor.Monitor, id int64) error {
 }
 
 func (r *MonitorResource) monitorFromPlan(ctx context.Context, plan MonitorResourceModel) (kumamonitor.Monitor, error) {
-	base := kumamonitor.Base{
-		Name:           plan.Name.ValueString(),
-		IsActive:       plan.Active.ValueBool(),
-		Interval:       plan.Interval.ValueInt64(),
-		RetryInterval:  plan.RetryInterval.ValueInt64(),
-		ResendInterval: plan.ResendInterval.ValueInt64(),
-		MaxRetries:     plan.MaxRetries.ValueInt64(),
-		UpsideDown:     plan.UpsideDown.ValueBool(),
-	}
-
-	// Notification IDs
-	if !plan.NotificationIDList.IsNull() && !plan.NotificationIDList.IsUnknown() {
-		var notifIDs []int64
-		plan.NotificationIDList.ElementsAs(ctx, &notifIDs, false)
-		base.NotificationIDs = notifIDs
-	}
-
-	// Tags
-	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
-		type tagModel struct {
-			TagID types.Int64  `tfsdk:"tag_id"`
-			Value types.String `tfsdk:"value"`
-		}
-		var tfTags []tagModel
-		plan.Tags.ElementsAs(ctx, &tfTags, false)
-
-		var tags []tag.MonitorTag
-		for _, t := range tfTags {
-			mt := tag.MonitorTag{
-				TagID: t.TagID.ValueInt64(),
-			}
-			if !t.Value.IsNull() {
-				mt.Value = t.Value.ValueString()
-			}
-			tags = append(tags, mt)
-		}
-		base.Tags = tags
-	}
+	base := plan.kumaBase(ctx)
 
 	switch plan.Type.ValueString() {
 	case "http":
-		m := &kumamonitor.HTTP{
-			Base: base,
-			HTTPDetails: kumamonitor.HTTPDetails{
-				URL:           plan.URL.ValueString(),
-				Method:        plan.Method.ValueString(),
-				IgnoreTLS:     plan.IgnoreTLS.ValueBool(),
-				MaxRedirects:  int(plan.MaxRedirects.ValueInt64()),
-				Body:          plan.Body.ValueString(),
-				Headers:       plan.Headers.ValueString(),
-				AuthMethod:    kumamonitor.AuthMethod(plan.AuthMethod.ValueString()),
-				BasicAuthUser: plan.BasicAuthUser.ValueString(),
-				BasicAuthPass: plan.BasicAuthPass.ValueString(),
-			},
-		}
-		// Always initialize AcceptedStatusCodes to empty slice to avoid sending null
-		m.AcceptedStatusCodes = []string{}
-		if !plan.AcceptedStatusCodes.IsNull() {
-			var codes []int64
-			plan.AcceptedStatusCodes.ElementsAs(ctx, &codes, false)
-			strCodes := make([]string, len(codes))
-			for i, c := range codes {
-				strCodes[i] = strconv.FormatInt(c, 10)
-			}
-			m.AcceptedStatusCodes = strCodes
+		httpDetails, err := plan.httpDetails(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return m, nil
+		return &kumamonitor.HTTP{Base: base, HTTPDetails: httpDetails}, nil
 
 	case "ping":
 		m := &kumamonitor.Ping{
 			Base: base,
 			PingDetails: kumamonitor.PingDetails{
-				Hostname: plan.Hostname.ValueString(),
+				Hostname:              plan.Hostname.ValueString(),
+				PingCount:             int(plan.PingCount.ValueInt64()),
+				PingNumeric:           plan.PingNumeric.ValueBool(),
+				PingPerRequestTimeout: int(plan.PingPerRequestTimeout.ValueInt64()),
+				Timeout:               plan.Timeout.ValueFloat64(),
 			},
 		}
+		if plan.Timeout.IsNull() || plan.Timeout.IsUnknown() {
+			m.Timeout = defaultPingTimeout
+		}
 		return m, nil
 
 	case "port":
@@ -660,215 +1222,674 @@ func (r *MonitorResource) monitorFromPlan(ctx context.Context, plan MonitorResou
 		return m, nil
 
 	case "keyword":
-		// Get method, default to GET if not specified
-		method := plan.Method.ValueString()
-		if method == "" {
-			method = "GET"
-		}
-
-		// Get max redirects, default to 0
-		maxRedirects := int(plan.MaxRedirects.ValueInt64())
-
-		// Map HTTP details
-		httpDetails := kumamonitor.HTTPDetails{
-			URL:           plan.URL.ValueString(),
-			Method:        method,
-			MaxRedirects:  maxRedirects,
-			Body:          plan.Body.ValueString(),
-			Headers:       plan.Headers.ValueString(),
-			AuthMethod:    kumamonitor.AuthMethod(plan.AuthMethod.ValueString()),
-			BasicAuthUser: plan.BasicAuthUser.ValueString(),
-			BasicAuthPass: plan.BasicAuthPass.ValueString(),
-			IgnoreTLS:     plan.IgnoreTLS.ValueBool(),
-		}
-
-		// Handle AcceptedStatusCodes
-		httpDetails.AcceptedStatusCodes = []string{}
-		if !plan.AcceptedStatusCodes.IsNull() {
-			var codes []int64
-			plan.AcceptedStatusCodes.ElementsAs(ctx, &codes, false)
-			strCodes := make([]string, len(codes))
-			for i, c := range codes {
-				strCodes[i] = strconv.FormatInt(c, 10)
-			}
-			httpDetails.AcceptedStatusCodes = strCodes
+		httpDetails, err := plan.httpDetails(ctx)
+		if err != nil {
+			return nil, err
 		}
 
 		m := &kumamonitor.HTTPKeyword{
 			Base:        base,
 			HTTPDetails: httpDetails,
 			HTTPKeywordDetails: kumamonitor.HTTPKeywordDetails{
-				Keyword: plan.Keyword.ValueString(),
+				Keyword:       plan.Keyword.ValueString(),
+				InvertKeyword: plan.InvertKeyword.ValueBool(),
 			},
 		}
 		return m, nil
 
-	default:
-		return nil, fmt.Errorf("unsupported monitor type: %s", plan.Type.ValueString())
-	}
-}
+	case "mqtt":
+		// Kuma treats an empty check type as keyword, but send it explicitly
+		checkType := plan.MQTTCheckType.ValueString()
+		if checkType == "" {
+			checkType = "keyword"
+		}
 
-func (r *MonitorResource) monitorToModel(ctx context.Context, m kumamonitor.Monitor, data *MonitorResourceModel) {
-	// Common fields
-	data.ID = types.Int64Value(m.GetID())
+		m := &kumamonitor.MQTT{
+			Base: base,
+			MQTTDetails: kumamonitor.MQTTDetails{
+				Hostname:           plan.Hostname.ValueString(),
+				Port:               int(plan.Port.ValueInt64()),
+				MQTTTopic:          plan.MQTTTopic.ValueString(),
+				MQTTUsername:       plan.MQTTUsername.ValueString(),
+				MQTTPassword:       plan.MQTTPassword.ValueString(),
+				MQTTSuccessMessage: plan.MQTTSuccessMessage.ValueString(),
+				MQTTCheckType:      checkType,
+			},
+		}
+		return m, nil
 
-	// Helper for tags
-	mapTags := func(tags []tag.MonitorTag) {
-		if len(tags) > 0 {
-			type tagModel struct {
-				TagID types.Int64  `tfsdk:"tag_id"`
-				Value types.String `tfsdk:"value"`
+	case "grpc-keyword":
+		m := &kumamonitor.GRPCKeyword{
+			Base: base,
+			GRPCKeywordDetails: kumamonitor.GRPCKeywordDetails{
+				GRPCURL:         plan.URL.ValueString(),
+				GRPCProtobuf:    plan.GRPCProtobuf.ValueString(),
+				GRPCServiceName: plan.GRPCServiceName.ValueString(),
+				GRPCMethod:      plan.GRPCMethod.ValueString(),
+				GRPCBody:        plan.GRPCBody.ValueString(),
+				GRPCEnableTLS:   plan.GRPCEnableTLS.ValueBool(),
+				Keyword:         plan.Keyword.ValueString(),
+				InvertKeyword:   plan.InvertKeyword.ValueBool(),
+			},
+		}
+		return m, nil
+
+	case "real-browser":
+		m := &kumamonitor.RealBrowser{
+			Base: base,
+			RealBrowserDetails: kumamonitor.RealBrowserDetails{
+				URL:           plan.URL.ValueString(),
+				Keyword:       plan.Keyword.ValueString(),
+				InvertKeyword: plan.InvertKeyword.ValueBool(),
+			},
+		}
+		if !plan.RemoteBrowserID.IsNull() && !plan.RemoteBrowserID.IsUnknown() {
+			remoteBrowserID := plan.RemoteBrowserID.ValueInt64()
+			m.RemoteBrowser = &remoteBrowserID
+		}
+
+		m.AcceptedStatusCodes = acceptedStatusCodes(ctx, plan.AcceptedStatusCodes)
+		return m, nil
+
+	case "kafka-producer":
+		m := &kumamonitor.KafkaProducer{
+			Base: base,
+			KafkaProducerDetails: kumamonitor.KafkaProducerDetails{
+				Brokers: []string{},
+				Topic:   plan.KafkaProducerTopic.ValueString(),
+				Message: plan.KafkaProducerMessage.ValueString(),
+				SSL:     plan.KafkaProducerSSL.ValueBool(),
+				SASLOptions: kumamonitor.KafkaProducerSASLOptions{
+					Mechanism: "None",
+				},
+			},
+		}
+		if !plan.KafkaProducerBrokers.IsNull() && !plan.KafkaProducerBrokers.IsUnknown() {
+			var brokers []string
+			plan.KafkaProducerBrokers.ElementsAs(ctx, &brokers, false)
+			m.Brokers = brokers
+		}
+		if !plan.KafkaProducerSASLOptions.IsNull() && !plan.KafkaProducerSASLOptions.IsUnknown() {
+			var sasl KafkaProducerSASLOptionsModel
+			plan.KafkaProducerSASLOptions.As(ctx, &sasl, basetypes.ObjectAsOptions{})
+			m.SASLOptions = kumamonitor.KafkaProducerSASLOptions{
+				Mechanism:             sasl.Mechanism.ValueString(),
+				Username:              sasl.Username.ValueString(),
+				Password:              sasl.Password.ValueString(),
+				AuthorizationIdentity: sasl.AuthorizationIdentity.ValueString(),
+				AccessKeyID:           sasl.AccessKeyID.ValueString(),
+				SecretAccessKey:       sasl.SecretAccessKey.ValueString(),
+				SessionToken:          sasl.SessionToken.ValueString(),
 			}
-			var tfTags []tagModel
-			for _, t := range tags {
-				tm := tagModel{
-					TagID: types.Int64Value(t.TagID),
-				}
-				if t.Value != "" {
-					tm.Value = types.StringValue(t.Value)
-				} else {
-					tm.Value = types.StringNull()
-				}
-				tfTags = append(tfTags, tm)
+		}
+		return m, nil
+
+	case "radius":
+		port := int(plan.Port.ValueInt64())
+		if plan.Port.IsNull() {
+			port = defaultRadiusPort
+		}
+
+		m := &kumamonitor.Radius{
+			Base: base,
+			RadiusDetails: kumamonitor.RadiusDetails{
+				Hostname:               plan.Hostname.ValueString(),
+				Port:                   port,
+				RadiusUsername:         plan.RadiusUsername.ValueString(),
+				RadiusPassword:         plan.RadiusPassword.ValueString(),
+				RadiusSecret:           plan.RadiusSecret.ValueString(),
+				RadiusCalledStationID:  plan.RadiusCalledStationID.ValueString(),
+				RadiusCallingStationID: plan.RadiusCallingStationID.ValueString(),
+			},
+		}
+		return m, nil
+
+	case "gamedig":
+		m := &kumamonitor.GameDig{
+			Base: base,
+			GameDigDetails: kumamonitor.GameDigDetails{
+				Game:                 plan.Game.ValueString(),
+				Hostname:             plan.Hostname.ValueString(),
+				Port:                 int(plan.Port.ValueInt64()),
+				GameDigGivenPortOnly: plan.GameDigGivenPortOnly.ValueBool(),
+			},
+		}
+		return m, nil
+
+	case "steam":
+		m := &kumamonitor.Steam{
+			Base: base,
+			SteamDetails: kumamonitor.SteamDetails{
+				Hostname: plan.Hostname.ValueString(),
+				Port:     int(plan.Port.ValueInt64()),
+			},
+		}
+		return m, nil
+
+	case "snmp":
+		port := int(plan.Port.ValueInt64())
+		if plan.Port.IsNull() {
+			port = defaultSNMPPort
+		}
+		version := snmpVersions["v2c"]
+		if !plan.SNMPVersion.IsNull() {
+			version = snmpVersions[plan.SNMPVersion.ValueString()]
+		}
+		jsonPath := plan.JSONPath.ValueString()
+		if jsonPath == "" {
+			jsonPath = defaultSNMPJSONPath
+		}
+
+		m := &kumamonitor.SNMP{
+			Base: base,
+			SNMPDetails: kumamonitor.SNMPDetails{
+				Hostname:              plan.Hostname.ValueString(),
+				Port:                  port,
+				SNMPVersion:           version,
+				SNMPCommunityString:   plan.SNMPCommunityString.ValueString(),
+				SNMPOID:               plan.SNMPOID.ValueString(),
+				SNMPV3Username:        plan.SNMPV3Username.ValueString(),
+				SNMPV3AuthPassword:    plan.SNMPV3AuthPassword.ValueString(),
+				SNMPV3PrivacyPassword: plan.SNMPV3PrivacyPassword.ValueString(),
+				JSONPath:              jsonPath,
+				JSONPathOperator:      plan.JSONPathOperator.ValueString(),
+				ExpectedValue:         plan.ExpectedValue.ValueString(),
+			},
+		}
+		return m, nil
+
+	case "rabbitmq":
+		m := &kumamonitor.RabbitMQ{
+			Base: base,
+			RabbitMQDetails: kumamonitor.RabbitMQDetails{
+				Nodes:    []string{},
+				Username: plan.RabbitMQUsername.ValueString(),
+				Password: plan.RabbitMQPassword.ValueString(),
+			},
+		}
+		if !plan.RabbitMQNodes.IsNull() && !plan.RabbitMQNodes.IsUnknown() {
+			var nodes []string
+			plan.RabbitMQNodes.ElementsAs(ctx, &nodes, false)
+			m.Nodes = nodes
+		}
+		return m, nil
+
+	case "tailscale-ping":
+		m := &kumamonitor.TailscalePing{
+			Base: base,
+			TailscalePingDetails: kumamonitor.TailscalePingDetails{
+				Hostname: plan.Hostname.ValueString(),
+			},
+		}
+		return m, nil
+
+	case "manual":
+		status := manualStatuses["up"]
+		if !plan.ManualStatus.IsNull() {
+			status = manualStatuses[plan.ManualStatus.ValueString()]
+		}
+
+		m := &kumamonitor.Manual{
+			Base: base,
+			ManualDetails: kumamonitor.ManualDetails{
+				ManualStatus: status,
+			},
+		}
+		return m, nil
+
+	default:
+		m := &kumamonitor.Generic{
+			Base:        base,
+			MonitorType: plan.Type.ValueString(),
+			Fields:      map[string]any{},
+		}
+		if !plan.RawConfig.IsNull() && !plan.RawConfig.IsUnknown() {
+			fields, err := plan.RawConfig.Object()
+			if err != nil {
+				return nil, fmt.Errorf("invalid raw_config: %w", err)
 			}
-			// Use struct to define element type implies ObjectType.
-			// We need to match the schema. Schema is ListNestedAttribute.
-			// ListValueFrom with struct slice works for ListNestedAttribute?
-			// usually yes if elements match.
-			// Actually ListValueFrom takes `elemType` which is `types.Type`.
-			// For nested attribute, it's `types.ObjectType`.
-			// But creating ObjectType manually is verbose.
-			// New approach: Use `types.ListValueFrom` with `types.ObjectType`.
-
-			objType := types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"tag_id": types.Int64Type,
-					"value":  types.StringType,
-				},
+			m.Fields = fields
+		}
+		if _, ok := monitorConditionVariables[plan.Type.ValueString()]; ok {
+			conditions := []kumaCondition{}
+			if !plan.Conditions.IsNull() && !plan.Conditions.IsUnknown() {
+				var err error
+				if conditions, err = monitorConditions(ctx, plan.Conditions); err != nil {
+					return nil, err
+				}
 			}
+			m.Fields["conditions"] = conditions
+		}
+		return m, nil
+	}
+}
+
+// setHTTPAuthDetails copies the ntlm, mtls and oauth2-cc attributes into the
+// library HTTP details. Basic auth credentials are mapped by the callers.
+func (m monitorHTTPModel) setHTTPAuthDetails(details *kumamonitor.HTTPDetails) {
+	details.AuthDomain = m.AuthDomain.ValueString()
+	details.AuthWorkstation = m.AuthWorkstation.ValueString()
+	details.TLSCert = m.TLSCert.ValueString()
+	details.TLSKey = m.TLSKey.ValueString()
+	details.TLSCa = m.TLSCa.ValueString()
+	details.OAuthClientID = m.OAuthClientID.ValueString()
+	details.OAuthClientSecret = m.OAuthClientSecret.ValueString()
+	details.OAuthTokenURL = m.OAuthTokenURL.ValueString()
+	details.OAuthScopes = m.OAuthScopes.ValueString()
+	details.OAuthAuthMethod = m.OAuthAuthMethod.ValueString()
+	if details.OAuthAuthMethod == "" {
+		details.OAuthAuthMethod = oauthAuthMethodSecretBasic
+	}
+}
+
+// setHTTPAttributes updates the request attributes of http and keyword
+// monitors from the library HTTP details.
+func (m *monitorHTTPModel) setHTTPAttributes(ctx context.Context, details kumamonitor.HTTPDetails) {
+	if details.URL != "" {
+		m.URL = types.StringValue(details.URL)
+	} else {
+		m.URL = types.StringNull()
+	}
+	// Uptime Kuma sends GET when no method is stored
+	m.Method = types.StringValue(details.Method)
+	if details.Method == "" {
+		m.Method = types.StringValue("GET")
+	}
+
+	m.IgnoreTLS = types.BoolValue(details.IgnoreTLS)
+	m.MaxRedirects = types.Int64Value(int64(details.MaxRedirects))
+
+	if details.Body != "" {
+		m.Body = types.StringValue(details.Body)
+	} else {
+		m.Body = types.StringNull()
+	}
+	m.setHeaders(ctx, details.Headers)
+
+	if string(details.AuthMethod) != "" {
+		m.AuthMethod = types.StringValue(string(details.AuthMethod))
+	} else {
+		m.AuthMethod = types.StringNull()
+	}
+	if details.BasicAuthUser != "" {
+		m.BasicAuthUser = types.StringValue(details.BasicAuthUser)
+	} else {
+		m.BasicAuthUser = types.StringNull()
+	}
+	if details.BasicAuthPass != "" {
+		m.BasicAuthPass = types.StringValue(details.BasicAuthPass)
+	} else {
+		m.BasicAuthPass = types.StringNull()
+	}
+	m.setHTTPAuthAttributes(details)
+	m.setHTTPRequestOptionAttributes(details)
+
+	m.AcceptedStatusCodes = acceptedStatusCodesValue(ctx, details.AcceptedStatusCodes)
+}
+
+// headersJSON returns the request headers sent to Uptime Kuma: either headers
+// as configured, or request_headers and sensitive_headers encoded as JSON.
+func (m monitorHTTPModel) headersJSON(ctx context.Context) (string, error) {
+	if m.RequestHeaders.IsNull() && m.SensitiveHeaders.IsNull() {
+		return m.Headers.ValueString(), nil
+	}
+
+	headers := map[string]string{}
+	for _, value := range []types.Map{m.RequestHeaders, m.SensitiveHeaders} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		var values map[string]string
+		if diags := value.ElementsAs(ctx, &values, false); diags.HasError() {
+			return "", fmt.Errorf("unable to read request headers: %v", diags)
+		}
+		maps.Copy(headers, values)
+	}
+
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode request headers: %w", err)
+	}
+	return string(encoded), nil
+}
+
+// setHeaders updates headers, request_headers and sensitive_headers from the
+// headers JSON returned by Uptime Kuma. When the headers were configured as
+// maps they are split back into them, keeping headers named in
+// sensitive_headers there; otherwise the JSON is stored in headers.
+func (m *monitorHTTPModel) setHeaders(ctx context.Context, headers string) {
+	mapped := !m.RequestHeaders.IsNull() || !m.SensitiveHeaders.IsNull()
+
+	// Headers that are not a JSON object of strings were edited outside
+	// Terraform and cannot be split, show them as headers instead
+	var values map[string]any
+	if headers != "" && json.Unmarshal([]byte(headers), &values) != nil {
+		mapped = false
+	}
+
+	if !mapped {
+		m.Headers = NewJSONObjectStringNull()
+		if headers != "" {
+			m.Headers = NewJSONObjectStringValue(headers)
+		}
+		m.RequestHeaders = types.MapNull(types.StringType)
+		m.SensitiveHeaders = types.MapNull(types.StringType)
+		return
+	}
+
+	var sensitive map[string]string
+	if !m.SensitiveHeaders.IsNull() {
+		m.SensitiveHeaders.ElementsAs(ctx, &sensitive, false)
+	}
+
+	requestValues := map[string]attr.Value{}
+	sensitiveValues := map[string]attr.Value{}
+	for name, value := range values {
+		text, ok := value.(string)
+		if !ok {
+			encoded, _ := json.Marshal(value)
+			text = string(encoded)
+		}
 
-			data.Tags, _ = types.ListValueFrom(ctx, objType, tfTags)
+		if _, ok := sensitive[name]; ok {
+			sensitiveValues[name] = types.StringValue(text)
 		} else {
-			elemType := types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"tag_id": types.Int64Type,
-					"value":  types.StringType,
-				},
-			}
-			data.Tags = types.ListNull(elemType)
+			requestValues[name] = types.StringValue(text)
 		}
 	}
 
+	m.Headers = NewJSONObjectStringNull()
+	m.RequestHeaders = headerMapValue(requestValues, m.RequestHeaders)
+	m.SensitiveHeaders = headerMapValue(sensitiveValues, m.SensitiveHeaders)
+}
+
+// headerMapValue returns the header map read back from Uptime Kuma, keeping
+// null when no headers were read and none were configured.
+func headerMapValue(values map[string]attr.Value, prior types.Map) types.Map {
+	if len(values) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// setHTTPRequestOptions copies the timeout, body encoding, cache busting,
+// certificate expiry and proxy attributes into the library HTTP details.
+func (m monitorHTTPModel) setHTTPRequestOptions(details *kumamonitor.HTTPDetails) {
+	details.Timeout = m.Timeout.ValueFloat64()
+	details.HTTPBodyEncoding = m.HTTPBodyEncoding.ValueString()
+	if details.HTTPBodyEncoding == "" {
+		details.HTTPBodyEncoding = defaultHTTPBodyEncoding
+	}
+	details.CacheBust = m.CacheBust.ValueBool()
+	details.ExpiryNotification = m.ExpiryNotification.ValueBool()
+	details.ProxyID = m.ProxyID.ValueInt64Pointer()
+}
+
+// setHTTPRequestOptionAttributes updates the timeout, body encoding, cache
+// busting, certificate expiry and proxy attributes from the library HTTP details.
+func (m *monitorHTTPModel) setHTTPRequestOptionAttributes(details kumamonitor.HTTPDetails) {
+	m.Timeout = types.Float64Value(details.Timeout)
+	// json is the server default, keep null when it was not configured
+	if details.HTTPBodyEncoding != "" && (details.HTTPBodyEncoding != defaultHTTPBodyEncoding || !m.HTTPBodyEncoding.IsNull()) {
+		m.HTTPBodyEncoding = types.StringValue(details.HTTPBodyEncoding)
+	}
+	m.CacheBust = types.BoolValue(details.CacheBust)
+	m.ExpiryNotification = types.BoolValue(details.ExpiryNotification)
+	// Monitors without a proxy may return 0
+	m.ProxyID = types.Int64Null()
+	if details.ProxyID != nil && *details.ProxyID != 0 {
+		m.ProxyID = types.Int64Value(*details.ProxyID)
+	}
+}
+
+// setHTTPAuthAttributes updates the ntlm, mtls and oauth2-cc attributes from
+// the library HTTP details.
+func (m *monitorHTTPModel) setHTTPAuthAttributes(details kumamonitor.HTTPDetails) {
+	m.AuthDomain = stringValueOrNull(details.AuthDomain)
+	m.AuthWorkstation = stringValueOrNull(details.AuthWorkstation)
+	m.TLSCert = stringValueOrNull(details.TLSCert)
+	m.TLSCa = stringValueOrNull(details.TLSCa)
+	m.OAuthClientID = stringValueOrNull(details.OAuthClientID)
+	m.OAuthTokenURL = stringValueOrNull(details.OAuthTokenURL)
+	m.OAuthScopes = stringValueOrNull(details.OAuthScopes)
+	m.TLSKey = secretValueOrPrior(details.TLSKey, m.TLSKey)
+	m.OAuthClientSecret = secretValueOrPrior(details.OAuthClientSecret, m.OAuthClientSecret)
+	// client_secret_basic is the server default, keep null when it was not configured
+	if details.OAuthAuthMethod != "" && (details.OAuthAuthMethod != oauthAuthMethodSecretBasic || !m.OAuthAuthMethod.IsNull()) {
+		m.OAuthAuthMethod = types.StringValue(details.OAuthAuthMethod)
+	}
+}
+
+func (r *MonitorResource) monitorToModel(ctx context.Context, m kumamonitor.Monitor, data *MonitorResourceModel) {
+	// Common fields
+	data.ID = types.Int64Value(m.GetID())
+
 	switch v := m.(type) {
 	case *kumamonitor.HTTP:
-		mapTags(v.Tags)
-		data.Name = types.StringValue(v.Name)
+		data.setFromKuma(ctx, v.Base)
 		data.Type = types.StringValue("http")
-		data.Active = types.BoolValue(v.IsActive)
+		data.setHTTPAttributes(ctx, v.HTTPDetails)
 
-		if v.URL != "" {
-			data.URL = types.StringValue(v.URL)
+	case *kumamonitor.Ping:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("ping")
+		if v.Hostname != "" {
+			data.Hostname = types.StringValue(v.Hostname)
 		} else {
-			data.URL = types.StringNull()
+			data.Hostname = types.StringNull()
 		}
-		if v.Method != "" {
-			data.Method = types.StringValue(v.Method)
+		data.PingCount = types.Int64Value(int64(v.PingCount))
+		data.PingNumeric = types.BoolValue(v.PingNumeric)
+		data.PingPerRequestTimeout = types.Int64Value(int64(v.PingPerRequestTimeout))
+		data.Timeout = types.Float64Value(v.Timeout)
+
+	case *kumamonitor.TCPPort:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("port")
+		if v.Hostname != "" {
+			data.Hostname = types.StringValue(v.Hostname)
 		} else {
-			data.Method = types.StringNull()
+			data.Hostname = types.StringNull()
 		}
+		data.Port = types.Int64Value(int64(v.Port))
 
-		data.IgnoreTLS = types.BoolValue(v.IgnoreTLS)
-		data.MaxRedirects = types.Int64Value(int64(v.MaxRedirects))
+	case *kumamonitor.HTTPKeyword:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("keyword")
+		data.setHTTPAttributes(ctx, v.HTTPDetails)
+		if v.Keyword != "" {
+			data.Keyword = types.StringValue(v.Keyword)
+		} else {
+			data.Keyword = types.StringNull()
+		}
+		data.InvertKeyword = types.BoolValue(v.InvertKeyword)
 
-		if v.Body != "" {
-			data.Body = types.StringValue(v.Body)
+	case *kumamonitor.MQTT:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("mqtt")
+		if v.Hostname != "" {
+			data.Hostname = types.StringValue(v.Hostname)
+		} else {
+			data.Hostname = types.StringNull()
+		}
+		data.Port = types.Int64Value(int64(v.Port))
+
+		if v.MQTTTopic != "" {
+			data.MQTTTopic = types.StringValue(v.MQTTTopic)
+		} else {
+			data.MQTTTopic = types.StringNull()
+		}
+		if v.MQTTUsername != "" {
+			data.MQTTUsername = types.StringValue(v.MQTTUsername)
+		} else {
+			data.MQTTUsername = types.StringNull()
+		}
+		if v.MQTTPassword != "" {
+			data.MQTTPassword = types.StringValue(v.MQTTPassword)
 		} else {
-			data.Body = types.StringNull()
+			data.MQTTPassword = types.StringNull()
 		}
-		if v.Headers != "" {
-			data.Headers = types.StringValue(v.Headers)
+		if v.MQTTSuccessMessage != "" {
+			data.MQTTSuccessMessage = types.StringValue(v.MQTTSuccessMessage)
 		} else {
-			data.Headers = types.StringNull()
+			data.MQTTSuccessMessage = types.StringNull()
+		}
+		if v.MQTTCheckType != "" {
+			data.MQTTCheckType = types.StringValue(v.MQTTCheckType)
+		} else {
+			data.MQTTCheckType = types.StringNull()
 		}
 
-		if string(v.AuthMethod) != "" {
-			data.AuthMethod = types.StringValue(string(v.AuthMethod))
+	case *kumamonitor.GRPCKeyword:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("grpc-keyword")
+		if v.GRPCURL != "" {
+			data.URL = types.StringValue(v.GRPCURL)
+		} else {
+			data.URL = types.StringNull()
+		}
+		if v.GRPCProtobuf != "" {
+			data.GRPCProtobuf = NewWhitespaceInsensitiveStringValue(v.GRPCProtobuf)
+		} else {
+			data.GRPCProtobuf = NewWhitespaceInsensitiveStringNull()
+		}
+		if v.GRPCServiceName != "" {
+			data.GRPCServiceName = types.StringValue(v.GRPCServiceName)
+		} else {
+			data.GRPCServiceName = types.StringNull()
+		}
+		if v.GRPCMethod != "" {
+			data.GRPCMethod = types.StringValue(v.GRPCMethod)
 		} else {
-			data.AuthMethod = types.StringNull()
+			data.GRPCMethod = types.StringNull()
 		}
-		if v.BasicAuthUser != "" {
-			data.BasicAuthUser = types.StringValue(v.BasicAuthUser)
+		if v.GRPCBody != "" {
+			data.GRPCBody = types.StringValue(v.GRPCBody)
 		} else {
-			data.BasicAuthUser = types.StringNull()
+			data.GRPCBody = types.StringNull()
 		}
-		if v.BasicAuthPass != "" {
-			data.BasicAuthPass = types.StringValue(v.BasicAuthPass)
+		data.GRPCEnableTLS = types.BoolValue(v.GRPCEnableTLS)
+		if v.Keyword != "" {
+			data.Keyword = types.StringValue(v.Keyword)
 		} else {
-			data.BasicAuthPass = types.StringNull()
+			data.Keyword = types.StringNull()
 		}
+		data.InvertKeyword = types.BoolValue(v.InvertKeyword)
 
-		if len(v.AcceptedStatusCodes) > 0 {
-			var codes []types.Int64
-			for _, c := range v.AcceptedStatusCodes {
-				if i, err := strconv.ParseInt(c, 10, 64); err == nil {
-					codes = append(codes, types.Int64Value(i))
-				}
-			}
-			data.AcceptedStatusCodes, _ = types.ListValueFrom(ctx, types.Int64Type, codes)
+	case *kumamonitor.RealBrowser:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("real-browser")
+		if v.URL != "" {
+			data.URL = types.StringValue(v.URL)
+		} else {
+			data.URL = types.StringNull()
+		}
+		if v.RemoteBrowser != nil {
+			data.RemoteBrowserID = types.Int64Value(*v.RemoteBrowser)
 		} else {
-			// If empty list, we prefer null to match config if omitted
-			data.AcceptedStatusCodes = types.ListNull(types.Int64Type)
+			data.RemoteBrowserID = types.Int64Null()
 		}
+		if v.Keyword != "" {
+			data.Keyword = types.StringValue(v.Keyword)
+		} else {
+			data.Keyword = types.StringNull()
+		}
+		data.InvertKeyword = types.BoolValue(v.InvertKeyword)
 
-		// Base fields
-		data.Interval = types.Int64Value(v.Interval)
-		data.RetryInterval = types.Int64Value(v.RetryInterval)
-		data.ResendInterval = types.Int64Value(v.ResendInterval)
-		data.MaxRetries = types.Int64Value(v.MaxRetries)
-		data.UpsideDown = types.BoolValue(v.UpsideDown)
+		data.AcceptedStatusCodes = acceptedStatusCodesValue(ctx, v.AcceptedStatusCodes)
 
-		if len(v.NotificationIDs) > 0 {
-			outIDs := make([]types.Int64, len(v.NotificationIDs))
-			for i, id := range v.NotificationIDs {
-				outIDs[i] = types.Int64Value(id)
+	case *kumamonitor.KafkaProducer:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("kafka-producer")
+		if len(v.Brokers) > 0 {
+			data.KafkaProducerBrokers, _ = types.ListValueFrom(ctx, types.StringType, v.Brokers)
+		} else {
+			data.KafkaProducerBrokers = types.ListNull(types.StringType)
+		}
+		if v.Topic != "" {
+			data.KafkaProducerTopic = types.StringValue(v.Topic)
+		} else {
+			data.KafkaProducerTopic = types.StringNull()
+		}
+		if v.Message != "" {
+			data.KafkaProducerMessage = types.StringValue(v.Message)
+		} else {
+			data.KafkaProducerMessage = types.StringNull()
+		}
+		data.KafkaProducerSSL = types.BoolValue(v.SSL)
+
+		// Kuma stores "None" when SASL is disabled
+		if v.SASLOptions.Mechanism != "" && v.SASLOptions.Mechanism != "None" {
+			prior := KafkaProducerSASLOptionsModel{
+				Password:        types.StringNull(),
+				SecretAccessKey: types.StringNull(),
+				SessionToken:    types.StringNull(),
+			}
+			if !data.KafkaProducerSASLOptions.IsNull() && !data.KafkaProducerSASLOptions.IsUnknown() {
+				data.KafkaProducerSASLOptions.As(ctx, &prior, basetypes.ObjectAsOptions{})
+			}
+			sasl := KafkaProducerSASLOptionsModel{
+				Mechanism:             types.StringValue(v.SASLOptions.Mechanism),
+				Username:              stringValueOrNull(v.SASLOptions.Username),
+				Password:              secretValueOrPrior(v.SASLOptions.Password, prior.Password),
+				AuthorizationIdentity: stringValueOrNull(v.SASLOptions.AuthorizationIdentity),
+				AccessKeyID:           stringValueOrNull(v.SASLOptions.AccessKeyID),
+				SecretAccessKey:       secretValueOrPrior(v.SASLOptions.SecretAccessKey, prior.SecretAccessKey),
+				SessionToken:          secretValueOrPrior(v.SASLOptions.SessionToken, prior.SessionToken),
 			}
-			data.NotificationIDList, _ = types.ListValueFrom(ctx, types.Int64Type, outIDs)
+			data.KafkaProducerSASLOptions, _ = types.ObjectValueFrom(ctx, kafkaProducerSASLOptionsAttrTypes(), sasl)
 		} else {
-			data.NotificationIDList = types.ListNull(types.Int64Type)
+			data.KafkaProducerSASLOptions = types.ObjectNull(kafkaProducerSASLOptionsAttrTypes())
 		}
 
-	case *kumamonitor.Ping:
-		mapTags(v.Tags)
-		data.Name = types.StringValue(v.Name)
-		data.Type = types.StringValue("ping")
-		data.Active = types.BoolValue(v.IsActive)
+	case *kumamonitor.Radius:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("radius")
 		if v.Hostname != "" {
 			data.Hostname = types.StringValue(v.Hostname)
 		} else {
 			data.Hostname = types.StringNull()
 		}
+		data.Port = types.Int64Value(int64(v.Port))
 
-		data.Interval = types.Int64Value(v.Interval)
-		data.RetryInterval = types.Int64Value(v.RetryInterval)
-		data.ResendInterval = types.Int64Value(v.ResendInterval)
-		data.MaxRetries = types.Int64Value(v.MaxRetries)
-		data.UpsideDown = types.BoolValue(v.UpsideDown)
+		if v.RadiusUsername != "" {
+			data.RadiusUsername = types.StringValue(v.RadiusUsername)
+		} else {
+			data.RadiusUsername = types.StringNull()
+		}
+		data.RadiusPassword = secretValueOrPrior(v.RadiusPassword, data.RadiusPassword)
+		data.RadiusSecret = secretValueOrPrior(v.RadiusSecret, data.RadiusSecret)
+		if v.RadiusCalledStationID != "" {
+			data.RadiusCalledStationID = types.StringValue(v.RadiusCalledStationID)
+		} else {
+			data.RadiusCalledStationID = types.StringNull()
+		}
+		if v.RadiusCallingStationID != "" {
+			data.RadiusCallingStationID = types.StringValue(v.RadiusCallingStationID)
+		} else {
+			data.RadiusCallingStationID = types.StringNull()
+		}
 
-		if len(v.NotificationIDs) > 0 {
-			outIDs := make([]types.Int64, len(v.NotificationIDs))
-			for i, id := range v.NotificationIDs {
-				outIDs[i] = types.Int64Value(id)
-			}
-			data.NotificationIDList, _ = types.ListValueFrom(ctx, types.Int64Type, outIDs)
+	case *kumamonitor.GameDig:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("gamedig")
+		if v.Game != "" {
+			data.Game = types.StringValue(v.Game)
 		} else {
-			data.NotificationIDList = types.ListNull(types.Int64Type)
+			data.Game = types.StringNull()
 		}
+		if v.Hostname != "" {
+			data.Hostname = types.StringValue(v.Hostname)
+		} else {
+			data.Hostname = types.StringNull()
+		}
+		data.Port = types.Int64Value(int64(v.Port))
+		data.GameDigGivenPortOnly = types.BoolValue(v.GameDigGivenPortOnly)
 
-	case *kumamonitor.TCPPort:
-		mapTags(v.Tags)
-		data.Name = types.StringValue(v.Name)
-		data.Type = types.StringValue("port")
-		data.Active = types.BoolValue(v.IsActive)
+	case *kumamonitor.Steam:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("steam")
 		if v.Hostname != "" {
 			data.Hostname = types.StringValue(v.Hostname)
 		} else {
@@ -876,52 +1897,110 @@ func (r *MonitorResource) monitorToModel(ctx context.Context, m kumamonitor.Moni
 		}
 		data.Port = types.Int64Value(int64(v.Port))
 
-		data.Interval = types.Int64Value(v.Interval)
-		data.RetryInterval = types.Int64Value(v.RetryInterval)
-		data.ResendInterval = types.Int64Value(v.ResendInterval)
-		data.MaxRetries = types.Int64Value(v.MaxRetries)
-		data.UpsideDown = types.BoolValue(v.UpsideDown)
+	case *kumamonitor.SNMP:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("snmp")
+		if v.Hostname != "" {
+			data.Hostname = types.StringValue(v.Hostname)
+		} else {
+			data.Hostname = types.StringNull()
+		}
+		data.Port = types.Int64Value(int64(v.Port))
 
-		if len(v.NotificationIDs) > 0 {
-			outIDs := make([]types.Int64, len(v.NotificationIDs))
-			for i, id := range v.NotificationIDs {
-				outIDs[i] = types.Int64Value(id)
+		// Map the stored version back to the attribute value
+		data.SNMPVersion = types.StringNull()
+		for name, version := range snmpVersions {
+			if version == v.SNMPVersion {
+				data.SNMPVersion = types.StringValue(name)
 			}
-			data.NotificationIDList, _ = types.ListValueFrom(ctx, types.Int64Type, outIDs)
+		}
+		if v.SNMPOID != "" {
+			data.SNMPOID = types.StringValue(v.SNMPOID)
+		} else {
+			data.SNMPOID = types.StringNull()
+		}
+		if v.SNMPV3Username != "" {
+			data.SNMPV3Username = types.StringValue(v.SNMPV3Username)
 		} else {
-			data.NotificationIDList = types.ListNull(types.Int64Type)
+			data.SNMPV3Username = types.StringNull()
 		}
+		data.SNMPCommunityString = secretValueOrPrior(v.SNMPCommunityString, data.SNMPCommunityString)
+		data.SNMPV3AuthPassword = secretValueOrPrior(v.SNMPV3AuthPassword, data.SNMPV3AuthPassword)
+		data.SNMPV3PrivacyPassword = secretValueOrPrior(v.SNMPV3PrivacyPassword, data.SNMPV3PrivacyPassword)
 
-	case *kumamonitor.HTTPKeyword:
-		mapTags(v.Tags)
-		data.Name = types.StringValue(v.Name)
-		data.Type = types.StringValue("keyword")
-		data.Active = types.BoolValue(v.IsActive)
-		if v.URL != "" {
-			data.URL = types.StringValue(v.URL)
+		if v.JSONPath != "" {
+			data.JSONPath = types.StringValue(v.JSONPath)
 		} else {
-			data.URL = types.StringNull()
+			data.JSONPath = types.StringNull()
 		}
-		if v.Keyword != "" {
-			data.Keyword = types.StringValue(v.Keyword)
+		if v.JSONPathOperator != "" {
+			data.JSONPathOperator = types.StringValue(v.JSONPathOperator)
 		} else {
-			data.Keyword = types.StringNull()
+			data.JSONPathOperator = types.StringNull()
+		}
+		if v.ExpectedValue != "" {
+			data.ExpectedValue = types.StringValue(v.ExpectedValue)
+		} else {
+			data.ExpectedValue = types.StringNull()
 		}
 
-		data.Interval = types.Int64Value(v.Interval)
-		data.RetryInterval = types.Int64Value(v.RetryInterval)
-		data.ResendInterval = types.Int64Value(v.ResendInterval)
-		data.MaxRetries = types.Int64Value(v.MaxRetries)
-		data.UpsideDown = types.BoolValue(v.UpsideDown)
+	case *kumamonitor.RabbitMQ:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("rabbitmq")
+		if len(v.Nodes) > 0 {
+			data.RabbitMQNodes, _ = types.ListValueFrom(ctx, types.StringType, v.Nodes)
+		} else {
+			data.RabbitMQNodes = types.ListNull(types.StringType)
+		}
+		if v.Username != "" {
+			data.RabbitMQUsername = types.StringValue(v.Username)
+		} else {
+			data.RabbitMQUsername = types.StringNull()
+		}
+		data.RabbitMQPassword = secretValueOrPrior(v.Password, data.RabbitMQPassword)
 
-		if len(v.NotificationIDs) > 0 {
-			outIDs := make([]types.Int64, len(v.NotificationIDs))
-			for i, id := range v.NotificationIDs {
-				outIDs[i] = types.Int64Value(id)
-			}
-			data.NotificationIDList, _ = types.ListValueFrom(ctx, types.Int64Type, outIDs)
+	case *kumamonitor.TailscalePing:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("tailscale-ping")
+		if v.Hostname != "" {
+			data.Hostname = types.StringValue(v.Hostname)
 		} else {
-			data.NotificationIDList = types.ListNull(types.Int64Type)
+			data.Hostname = types.StringNull()
+		}
+
+	case *kumamonitor.Manual:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue("manual")
+		// Map the status code back to the attribute value, a status the
+		// provider does not know shows as drift
+		data.ManualStatus = types.StringNull()
+		for name, status := range manualStatuses {
+			if status == v.ManualStatus {
+				data.ManualStatus = types.StringValue(name)
+			}
+		}
+
+	case *kumamonitor.Generic:
+		data.setFromKuma(ctx, v.Base)
+		data.Type = types.StringValue(v.MonitorType)
+		// Only read back the configured keys, the server returns every
+		// monitor field whether it applies to the type or not
+		if !data.RawConfig.IsNull() && !data.RawConfig.IsUnknown() {
+			configured, _ := data.RawConfig.Object()
+			fields := make(map[string]any, len(configured))
+			for key := range configured {
+				if value, ok := v.Fields[key]; ok {
+					fields[key] = value
+				}
+			}
+			if out, err := json.Marshal(fields); err == nil {
+				data.RawConfig = NewJSONObjectStringValue(string(out))
+			}
+		}
+		if _, ok := monitorConditionVariables[v.MonitorType]; ok {
+			if conditions, err := monitorConditionsValue(ctx, v.Fields["conditions"]); err == nil {
+				data.Conditions = conditions
+			}
 		}
 
 	default:
@@ -0,0 +1,136 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var _ resource.ResourceWithUpgradeState = &MonitorResource{}
+
+// monitorStateUpgrades converts the raw state of each prior schema version of
+// uptimekuma_monitor into the next version. The index is the version it
+// upgrades from.
+var monitorStateUpgrades = []func(state map[string]any) error{
+	// 0: accepted_status_codes was a list of numbers
+	upgradeMonitorAcceptedStatusCodes,
+	// 1: tags was a list
+	upgradeMonitorTags,
+}
+
+// monitorSchemaVersion is the current schema version of uptimekuma_monitor.
+var monitorSchemaVersion = int64(len(monitorStateUpgrades))
+
+func (r *MonitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(monitorStateUpgrades))
+	for version := range monitorStateUpgrades {
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeMonitorState(version, req, resp)
+			},
+		}
+	}
+	return upgraders
+}
+
+// upgradeMonitorState applies the upgrades from the given version up to the
+// current version to the raw JSON state. Working on the raw state avoids
+// keeping a copy of every prior schema; attributes added since then are null.
+func upgradeMonitorState(version int, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade State",
+			fmt.Sprintf("The monitor state of schema version %d is not available as JSON.", version),
+		)
+		return
+	}
+
+	// Keep numbers as written, so that IDs and intervals are not rounded
+	dec := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	dec.UseNumber()
+	var state map[string]any
+	if err := dec.Decode(&state); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade State",
+			fmt.Sprintf("Unable to parse the monitor state of schema version %d: %s", version, err),
+		)
+		return
+	}
+
+	for v, upgrade := range monitorStateUpgrades[version:] {
+		if err := upgrade(state); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade State",
+				fmt.Sprintf("Unable to upgrade the monitor state from schema version %d: %s", version+v, err),
+			)
+			return
+		}
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade State",
+			fmt.Sprintf("Unable to encode the upgraded monitor state: %s", err),
+		)
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// upgradeMonitorAcceptedStatusCodes converts accepted_status_codes from a list
+// of numbers into a set of strings.
+func upgradeMonitorAcceptedStatusCodes(state map[string]any) error {
+	codes, ok := state["accepted_status_codes"].([]any)
+	if !ok {
+		// null stays null, the next refresh reads the server value
+		return nil
+	}
+
+	// A list may hold duplicates, a set may not
+	upgraded := make([]any, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		number, ok := code.(json.Number)
+		if !ok {
+			return fmt.Errorf("accepted_status_codes contains %v, expected a number", code)
+		}
+		if !seen[number.String()] {
+			seen[number.String()] = true
+			upgraded = append(upgraded, number.String())
+		}
+	}
+	state["accepted_status_codes"] = upgraded
+	return nil
+}
+
+// upgradeMonitorTags converts tags from a list into a set of tag and value
+// pairs. Both are encoded as arrays, only duplicate pairs are dropped.
+func upgradeMonitorTags(state map[string]any) error {
+	tags, ok := state["tags"].([]any)
+	if !ok {
+		return nil
+	}
+
+	upgraded := make([]any, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		key, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Errorf("tags contains %v: %w", t, err)
+		}
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			upgraded = append(upgraded, t)
+		}
+	}
+	state["tags"] = upgraded
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testUpgradeMonitorState upgrades a raw JSON state of the given schema
+// version and returns it as the current data model.
+func testUpgradeMonitorState(t *testing.T, version int64, rawState string) MonitorResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &MonitorResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("no state upgrader for version %d", version)
+	}
+
+	resp := &resource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	value, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("upgraded state does not match the schema: %s", err)
+	}
+
+	var data MonitorResourceModel
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: value}
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return data
+}
+
+func TestMonitorStateUpgradeV0(t *testing.T) {
+	ctx := context.Background()
+
+	data := testUpgradeMonitorState(t, 0, `{
+		"id": 12,
+		"name": "API",
+		"type": "http",
+		"url": "https://example.com",
+		"interval": 60,
+		"accepted_status_codes": [200, 204, 200]
+	}`)
+
+	if data.ID.ValueInt64() != 12 || data.URL.ValueString() != "https://example.com" {
+		t.Errorf("unexpected upgraded state: %+v", data)
+	}
+
+	want, _ := types.SetValueFrom(ctx, types.StringType, []string{"200", "204"})
+	if !data.AcceptedStatusCodes.Equal(want) {
+		t.Errorf("expected accepted_status_codes %s, got %s", want, data.AcceptedStatusCodes)
+	}
+
+	data = testUpgradeMonitorState(t, 0, `{"id": 13, "name": "Ping", "type": "ping", "accepted_status_codes": null}`)
+	if !data.AcceptedStatusCodes.IsNull() {
+		t.Errorf("expected null accepted_status_codes, got %s", data.AcceptedStatusCodes)
+	}
+}
+
+func TestMonitorStateUpgradeV1(t *testing.T) {
+	ctx := context.Background()
+
+	data := testUpgradeMonitorState(t, 1, `{
+		"id": 12,
+		"name": "API",
+		"type": "http",
+		"tags": [
+			{"tag_id": 1, "value": "api"},
+			{"tag_id": 2, "value": null},
+			{"tag_id": 1, "value": "api"}
+		]
+	}`)
+
+	var tags []monitorTagModel
+	if diags := data.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(tags) != 2 {
+		t.Errorf("expected 2 distinct tags, got %s", data.Tags)
+	}
+
+	// Upgrading from version 0 applies both upgrades
+	data = testUpgradeMonitorState(t, 0, `{
+		"id": 13,
+		"name": "API",
+		"type": "http",
+		"accepted_status_codes": [200],
+		"tags": [{"tag_id": 1, "value": "api"}]
+	}`)
+	if len(data.Tags.Elements()) != 1 || len(data.AcceptedStatusCodes.Elements()) != 1 {
+		t.Errorf("unexpected upgraded state: %+v", data)
+	}
+
+	data = testUpgradeMonitorState(t, 1, `{"id": 14, "name": "Ping", "type": "ping", "tags": null}`)
+	if !data.Tags.IsNull() {
+		t.Errorf("expected null tags, got %s", data.Tags)
+	}
+}
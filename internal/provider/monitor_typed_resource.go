@@ -0,0 +1,325 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumamonitor "github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &typedMonitorResource{}
+var _ resource.ResourceWithImportState = &typedMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &typedMonitorResource{}
+var _ resource.ResourceWithConfigValidators = &typedMonitorResource{}
+
+// typedMonitorModel is implemented by the data models of the per-type monitor
+// resources (uptimekuma_monitor_http, uptimekuma_monitor_ping, ...). Each model
+// embeds MonitorBaseModel and only carries the fields its type supports.
+type typedMonitorModel interface {
+	// base returns the embedded shared attributes.
+	base() *MonitorBaseModel
+	// toMonitor converts the model into the library monitor.
+	toMonitor(ctx context.Context) (kumamonitor.Monitor, error)
+	// fromMonitor updates the model from the monitor read from Uptime Kuma.
+	fromMonitor(ctx context.Context, m kumamonitor.Base) error
+}
+
+// typedMonitorResource implements the per-type monitor resources. The
+// resources only differ in their schema and data model, the CRUD logic is
+// shared.
+type typedMonitorResource struct {
+	client *client.Client
+
+	// monitorType is the Uptime Kuma monitor type, e.g. http.
+	monitorType string
+	// typeNameSuffix is appended to the provider type name, e.g. _monitor_http.
+	typeNameSuffix string
+	description    string
+	attributes     func() map[string]schema.Attribute
+	newModel       func() typedMonitorModel
+}
+
+func (r *typedMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix
+}
+
+func (r *typedMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.description,
+		Attributes:          withMonitorBaseAttributes(r.attributes()),
+	}
+}
+
+func (r *typedMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *typedMonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	var validators []resource.ConfigValidator
+	if monitorTypeSupports(r.monitorType, "auth_method") {
+		validators = append(validators, monitorAuthMethodAttributesValidator{monitorType: r.monitorType})
+	}
+	if monitorTypeSupports(r.monitorType, "request_headers") {
+		validators = append(validators, monitorHeadersValidator{})
+	}
+	// Check the variables and operators of the conditions of the types that support them
+	if _, ok := monitorConditionVariables[r.monitorType]; ok {
+		validators = append(validators, monitorConditionsValidator{monitorType: r.monitorType})
+	}
+	if r.monitorType == "ping" {
+		validators = append(validators, monitorPingTimeoutValidator{monitorType: r.monitorType})
+	}
+	return validators
+}
+
+func (r *typedMonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The monitor_defaults of the provider apply to unset attributes, and
+	// before the timeout, which depends on the interval
+	resp.Diagnostics.Append(planMonitorDefaults(ctx, r.client, req.Config, &resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plan the timeout Uptime Kuma uses when none is configured, like
+	// uptimekuma_monitor does
+	if monitorTypeSupports(r.monitorType, "timeout") {
+		var interval types.Int64
+		var configTimeout types.Float64
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("interval"), &interval)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &configTimeout)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if configTimeout.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("timeout"), defaultMonitorTimeout(types.StringValue(r.monitorType), interval))...)
+		}
+	}
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	// The schema default of accepted_status_codes is the Uptime Kuma default
+	if codes := r.client.MonitorDefaults.AcceptedStatusCodes; codes != nil && monitorTypeSupports(r.monitorType, "accepted_status_codes") {
+		var configStatusCodes types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("accepted_status_codes"), &configStatusCodes)...)
+		if configStatusCodes.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("accepted_status_codes"), acceptedStatusCodesValue(ctx, codes))...)
+		}
+	}
+
+	// Plan the IDs of the tags referenced by name and the default tags
+	resp.Diagnostics.Append(planMonitorTags(ctx, r.client, &resp.Plan)...)
+}
+
+func (r *typedMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := r.newModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the tags referenced by name, creating the missing ones if allowed
+	resp.Diagnostics.Append(resolvePlannedMonitorTags(ctx, r.client, data.base())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := data.toMonitor(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating monitor", err.Error())
+		return
+	}
+
+	id, err := r.client.Kuma.CreateMonitor(ctx, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create monitor: %s", err))
+		return
+	}
+
+	base := data.base()
+	base.ID = types.Int64Value(id)
+
+	// Monitors are created active, pause if active=false
+	if !base.Active.ValueBool() {
+		if err := setMonitorActive(ctx, r.client, id, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set active state: %s", err))
+			return
+		}
+	}
+
+	if err := createMonitorTags(ctx, r.client, id, base.TagsAll); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set tags: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *typedMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := r.newModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := data.base().ID.ValueInt64()
+
+	baseMonitor, err := r.client.Kuma.GetMonitor(ctx, monitorID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor %d: %s", monitorID, err))
+		return
+	}
+
+	if baseMonitor.ID == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if baseMonitor.Type() != r.monitorType {
+		resp.Diagnostics.AddError(
+			"Unexpected Monitor Type",
+			fmt.Sprintf("Monitor %d is a %q monitor, but uptimekuma%s only manages %q monitors.", monitorID, baseMonitor.Type(), r.typeNameSuffix, r.monitorType),
+		)
+		return
+	}
+
+	if err := data.fromMonitor(ctx, baseMonitor); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert monitor: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *typedMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := r.newModel()
+	stateData := r.newModel()
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the tags referenced by name, creating the missing ones if allowed
+	resp.Diagnostics.Append(resolvePlannedMonitorTags(ctx, r.client, data.base())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := data.toMonitor(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error preparing monitor update", err.Error())
+		return
+	}
+
+	base := data.base()
+	idVal := base.ID.ValueInt64()
+
+	// The prior monitor tells which fields were removed from the configuration
+	prior, err := stateData.toMonitor(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error preparing monitor update", fmt.Sprintf("Unable to read the prior state: %s", err))
+		return
+	}
+
+	// Keep the fields of the stored monitor the provider doesn't manage
+	monitor, err = monitorForUpdate(ctx, r.client, idVal, prior, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	if err := r.client.Kuma.UpdateMonitor(ctx, monitor); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor %d: %s", idVal, err))
+		return
+	}
+
+	if err := updateMonitorTags(ctx, r.client, idVal, base.TagsAll); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tags: %s", err))
+		return
+	}
+
+	if base.Active.ValueBool() != stateData.base().Active.ValueBool() {
+		if err := setMonitorActive(ctx, r.client, idVal, base.Active.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set active state: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *typedMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := r.newModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := data.base().ID.ValueInt64()
+
+	if err := r.client.Kuma.DeleteMonitor(ctx, monitorID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete monitor %d: %s", monitorID, err))
+		return
+	}
+}
+
+func (r *typedMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Monitor ID",
+			fmt.Sprintf("Monitor ID must be a number, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestTypedMonitorResourceSchemas checks that the schema of every per-type
+// monitor resource is valid, matches its data model and has the
+// type-specific attributes uptimekuma_monitor accepts for the type.
+func TestTypedMonitorResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range []func() resource.Resource{
+		NewMonitorHTTPResource,
+		NewMonitorKeywordResource,
+		NewMonitorPingResource,
+		NewMonitorPortResource,
+		NewMonitorDNSResource,
+	} {
+		r := newResource().(*typedMonitorResource)
+
+		t.Run(r.monitorType, func(t *testing.T) {
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			if schemaResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+			}
+			if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid schema: %v", diags)
+			}
+
+			attributes := monitorTypeAttributeTable[r.monitorType]
+			for _, name := range slices.Concat(attributes.required, attributes.optional) {
+				if _, ok := schemaResp.Schema.Attributes[name]; !ok {
+					t.Errorf("missing the %s attribute of %s monitors", name, r.monitorType)
+				}
+			}
+
+			// Read a state with every attribute null into the model
+			objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+			for name, typ := range objType.AttributeTypes {
+				attrs[name] = tftypes.NewValue(typ, nil)
+			}
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objType, attrs),
+			}
+
+			if diags := state.Get(ctx, r.newModel()); diags.HasError() {
+				t.Fatalf("model does not match schema: %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.ConfigValidator = monitorTypeAttributesValidator{}
+var _ resource.ConfigValidator = monitorAuthMethodAttributesValidator{}
+var _ resource.ConfigValidator = monitorHeadersValidator{}
+var _ resource.ConfigValidator = monitorConditionsValidator{}
+var _ resource.ConfigValidator = monitorPingTimeoutValidator{}
+
+// monitorTypeAttributes lists the type-specific attributes of a monitor type.
+// Type-specific attributes that are neither required nor optional are
+// forbidden, since the monitor would silently ignore them.
+type monitorTypeAttributes struct {
+	required []string
+	optional []string
+}
+
+// monitorHTTPAuthAttributes are the credentials of the http authentication methods.
+var monitorHTTPAuthAttributes = []string{
+	"basic_auth_user", "basic_auth_pass", "auth_domain", "auth_workstation", "tls_cert", "tls_key", "tls_ca",
+	"oauth_client_id", "oauth_client_secret", "oauth_token_url", "oauth_scopes", "oauth_auth_method",
+}
+
+// monitorHTTPRequestAttributes are the request attributes shared by http and keyword monitors.
+var monitorHTTPRequestAttributes = slices.Concat([]string{
+	"method", "ignore_tls", "max_redirects", "body", "headers", "auth_method", "accepted_status_codes",
+	"timeout", "http_body_encoding", "cache_bust", "expiry_notification", "request_headers", "sensitive_headers",
+	"proxy_id",
+}, monitorHTTPAuthAttributes)
+
+// monitorTypeAttributeTable holds the type-specific attributes of every
+// monitor type with dedicated attributes. Any other type is managed
+// generically and only accepts raw_config.
+var monitorTypeAttributeTable = map[string]monitorTypeAttributes{
+	"http": {
+		required: []string{"url"},
+		optional: monitorHTTPRequestAttributes,
+	},
+	"keyword": {
+		required: []string{"url", "keyword"},
+		optional: append([]string{"invert_keyword"}, monitorHTTPRequestAttributes...),
+	},
+	"ping": {
+		required: []string{"hostname"},
+		optional: []string{"ping_count", "ping_numeric", "ping_per_request_timeout", "timeout"},
+	},
+	"port": {
+		required: []string{"hostname", "port"},
+	},
+	"mqtt": {
+		required: []string{"hostname", "port", "mqtt_topic"},
+		optional: []string{"mqtt_username", "mqtt_password", "mqtt_success_message", "mqtt_check_type"},
+	},
+	"grpc-keyword": {
+		required: []string{"url", "grpc_service_name", "grpc_method", "keyword"},
+		optional: []string{"grpc_protobuf", "grpc_body", "grpc_enable_tls", "invert_keyword"},
+	},
+	"real-browser": {
+		required: []string{"url"},
+		optional: []string{"remote_browser_id", "accepted_status_codes", "keyword", "invert_keyword"},
+	},
+	"kafka-producer": {
+		required: []string{"kafka_producer_brokers", "kafka_producer_topic"},
+		optional: []string{"kafka_producer_message", "kafka_producer_ssl", "kafka_producer_sasl_options"},
+	},
+	"radius": {
+		required: []string{"hostname", "radius_username", "radius_password", "radius_secret"},
+		optional: []string{"port", "radius_called_station_id", "radius_calling_station_id"},
+	},
+	"gamedig": {
+		required: []string{"hostname", "port", "game"},
+		optional: []string{"gamedig_given_port_only"},
+	},
+	"steam": {
+		required: []string{"hostname", "port"},
+	},
+	"snmp": {
+		required: []string{"hostname", "snmp_oid", "json_path_operator", "expected_value"},
+		optional: []string{
+			"port", "snmp_version", "snmp_community_string", "snmp_v3_username",
+			"snmp_v3_auth_password", "snmp_v3_privacy_password", "json_path",
+		},
+	},
+	"rabbitmq": {
+		required: []string{"rabbitmq_nodes", "rabbitmq_username", "rabbitmq_password"},
+	},
+	"tailscale-ping": {
+		required: []string{"hostname"},
+	},
+	"manual": {
+		optional: []string{"manual_status"},
+	},
+}
+
+// monitorAuthMethodAttributeTable holds the credentials each auth_method of an
+// http or keyword monitor uses. The empty key covers monitors without an
+// auth_method, which use no credentials.
+var monitorAuthMethodAttributeTable = map[string]monitorTypeAttributes{
+	"": {},
+	"basic": {
+		required: []string{"basic_auth_user", "basic_auth_pass"},
+	},
+	"ntlm": {
+		required: []string{"basic_auth_user", "basic_auth_pass"},
+		optional: []string{"auth_domain", "auth_workstation"},
+	},
+	"mtls": {
+		required: []string{"tls_cert", "tls_key"},
+		optional: []string{"tls_ca"},
+	},
+	"oauth2-cc": {
+		required: []string{"oauth_client_id", "oauth_client_secret", "oauth_token_url"},
+		optional: []string{"oauth_scopes", "oauth_auth_method"},
+	},
+}
+
+// monitorUnmodeledTypeAttributes are the type-specific attributes accepted by
+// monitor types without an entry in monitorTypeAttributeTable.
+var monitorUnmodeledTypeAttributes = monitorTypeAttributes{
+	optional: []string{"raw_config", "conditions"},
+}
+
+// rawConfigReservedKeys are monitor fields managed by dedicated attributes,
+// which raw_config must not override.
+var rawConfigReservedKeys = []string{
+	"id", "name", "type", "description", "active", "interval", "retryInterval", "resendInterval",
+	"maxretries", "upsideDown", "notificationIDList", "tags", "conditions",
+}
+
+// monitorCommonAttributes are accepted by every monitor type.
+var monitorCommonAttributes = []string{
+	"id", "type", "name", "description", "active", "interval", "retry_interval", "resend_interval",
+	"max_retries", "upside_down", "notification_id_list", "tags",
+}
+
+// monitorTypeAttributesValidator checks the configured attributes of a
+// monitor against monitorTypeAttributeTable.
+type monitorTypeAttributesValidator struct{}
+
+func (v monitorTypeAttributesValidator) Description(ctx context.Context) string {
+	return "Checks that the required attributes of the monitor type are set and that attributes of other monitor types are not."
+}
+
+func (v monitorTypeAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v monitorTypeAttributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || monitorType.IsNull() || monitorType.IsUnknown() {
+		return
+	}
+
+	values, ok := monitorConfigValues(req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	typeName := monitorType.ValueString()
+	attributes, modeled := monitorTypeAttributeTable[typeName]
+	if !modeled {
+		attributes = monitorUnmodeledTypeAttributes
+	}
+
+	var candidates []string
+	for name := range values {
+		if name == "raw_config" && !values[name].IsNull() && !slices.Contains(attributes.optional, name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("raw_config is only supported for monitor types without dedicated attributes; use the %s monitor attributes instead.", typeName),
+			)
+			continue
+		}
+		if !slices.Contains(monitorCommonAttributes, name) {
+			candidates = append(candidates, name)
+		}
+	}
+
+	checkMonitorAttributes(&resp.Diagnostics, values, candidates, attributes, typeName+" monitors")
+
+	if !modeled {
+		var rawConfig JSONObjectString
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raw_config"), &rawConfig)...)
+		if resp.Diagnostics.HasError() || rawConfig.IsNull() || rawConfig.IsUnknown() {
+			return
+		}
+
+		// Invalid JSON is reported by the attribute type itself
+		fields, err := rawConfig.Object()
+		if err != nil {
+			return
+		}
+		for _, key := range rawConfigReservedKeys {
+			if _, ok := fields[key]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("raw_config"),
+					"Invalid raw_config Key",
+					fmt.Sprintf("The %q field is managed by a dedicated attribute and cannot be set in raw_config.", key),
+				)
+			}
+		}
+	}
+}
+
+// monitorPingTimeoutValidator checks the timeout of ping monitors, which has
+// tighter bounds than the timeout of other monitor types.
+type monitorPingTimeoutValidator struct {
+	// monitorType is the monitor type of the per-type monitor resources,
+	// which have no type attribute. Empty for uptimekuma_monitor.
+	monitorType string
+}
+
+func (v monitorPingTimeoutValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Checks that the timeout of ping monitors is between %d and %d seconds.", minPingTimeout, maxPingTimeout)
+}
+
+func (v monitorPingTimeoutValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v monitorPingTimeoutValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	monitorType := types.StringValue(v.monitorType)
+	if v.monitorType == "" {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	}
+	var timeout types.Float64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() || monitorType.ValueString() != "ping" || timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+
+	if t := timeout.ValueFloat64(); t < minPingTimeout || t > maxPingTimeout {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The timeout of ping monitors must be between %d and %d seconds, got: %g.", minPingTimeout, maxPingTimeout, t),
+		)
+	}
+}
+
+// monitorAuthMethodAttributesValidator checks the credentials of an http or
+// keyword monitor against monitorAuthMethodAttributeTable.
+type monitorAuthMethodAttributesValidator struct {
+	// monitorType is the monitor type of the per-type monitor resources,
+	// which have no type attribute. Empty for uptimekuma_monitor.
+	monitorType string
+}
+
+func (v monitorAuthMethodAttributesValidator) Description(ctx context.Context) string {
+	return "Checks that the credentials required by auth_method are set and that credentials of other authentication methods are not."
+}
+
+func (v monitorAuthMethodAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v monitorAuthMethodAttributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	monitorType := types.StringValue(v.monitorType)
+	if v.monitorType == "" {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	}
+	var authMethod types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_method"), &authMethod)...)
+	if resp.Diagnostics.HasError() || monitorType.IsUnknown() || authMethod.IsUnknown() {
+		return
+	}
+
+	// Credentials on other monitor types are reported by monitorTypeAttributesValidator
+	if !monitorTypeSupports(monitorType.ValueString(), "auth_method") {
+		return
+	}
+
+	// Unsupported methods are reported by the auth_method attribute validator
+	attributes, ok := monitorAuthMethodAttributeTable[authMethod.ValueString()]
+	if !ok {
+		return
+	}
+
+	values, ok := monitorConfigValues(req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	subject := "monitors without an auth_method"
+	if authMethod.ValueString() != "" {
+		subject = fmt.Sprintf("monitors with auth_method %q", authMethod.ValueString())
+	}
+	checkMonitorAttributes(&resp.Diagnostics, values, monitorHTTPAuthAttributes, attributes, subject)
+}
+
+// monitorHeadersValidator checks that no header is set in both
+// request_headers and sensitive_headers. Header names are case-insensitive.
+type monitorHeadersValidator struct{}
+
+func (v monitorHeadersValidator) Description(ctx context.Context) string {
+	return "Checks that no header is set in both request_headers and sensitive_headers."
+}
+
+func (v monitorHeadersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v monitorHeadersValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var requestHeaders, sensitiveHeaders types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("request_headers"), &requestHeaders)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_headers"), &sensitiveHeaders)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]string, len(requestHeaders.Elements()))
+	for name := range requestHeaders.Elements() {
+		names[strings.ToLower(name)] = name
+	}
+	for name := range sensitiveHeaders.Elements() {
+		if requestName, ok := names[strings.ToLower(name)]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sensitive_headers").AtMapKey(name),
+				"Duplicate Header",
+				fmt.Sprintf("The %q header is also set in request_headers as %q. Set each header in only one of them.", name, requestName),
+			)
+		}
+	}
+}
+
+// monitorTypeSupports reports whether a monitor type with dedicated attributes
+// accepts the named type-specific attribute.
+func monitorTypeSupports(monitorType, name string) bool {
+	attributes := monitorTypeAttributeTable[monitorType]
+	return slices.Contains(attributes.required, name) || slices.Contains(attributes.optional, name)
+}
+
+// monitorConditionsValidator checks the variables and operators of the
+// conditions against monitorConditionVariables.
+type monitorConditionsValidator struct {
+	// monitorType is the monitor type of the per-type monitor resources,
+	// which have no type attribute. Empty for uptimekuma_monitor.
+	monitorType string
+}
+
+func (v monitorConditionsValidator) Description(ctx context.Context) string {
+	return "Checks that the monitor type supports conditions and that each clause uses a variable and operator of the monitor type."
+}
+
+func (v monitorConditionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v monitorConditionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var conditions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("conditions"), &conditions)...)
+	if resp.Diagnostics.HasError() || conditions.IsNull() || conditions.IsUnknown() {
+		return
+	}
+
+	typeName := v.monitorType
+	if typeName == "" {
+		var monitorType types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+		if resp.Diagnostics.HasError() || monitorType.IsUnknown() {
+			return
+		}
+
+		// Conditions on monitor types with dedicated attributes are reported by monitorTypeAttributesValidator
+		typeName = monitorType.ValueString()
+		if _, modeled := monitorTypeAttributeTable[typeName]; modeled {
+			return
+		}
+	}
+
+	variables, ok := monitorConditionVariables[typeName]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("conditions"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The \"conditions\" attribute is not supported by %s monitors. Monitor types with conditions: %s.", typeName, strings.Join(slices.Sorted(maps.Keys(monitorConditionVariables)), ", ")),
+		)
+		return
+	}
+
+	var groups []monitorConditionGroupModel
+	resp.Diagnostics.Append(conditions.ElementsAs(ctx, &groups, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, group := range groups {
+		var clauses []monitorConditionClauseModel
+		resp.Diagnostics.Append(group.Clauses.ElementsAs(ctx, &clauses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for j, clause := range clauses {
+			clausePath := path.Root("conditions").AtListIndex(i).AtName("clauses").AtListIndex(j)
+			if clause.Variable.IsUnknown() {
+				continue
+			}
+
+			operators, ok := variables[clause.Variable.ValueString()]
+			if !ok {
+				resp.Diagnostics.AddAttributeError(
+					clausePath.AtName("variable"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("The %q variable is not supported by %s monitors. Supported variables: %s.", clause.Variable.ValueString(), typeName, strings.Join(slices.Sorted(maps.Keys(variables)), ", ")),
+				)
+				continue
+			}
+			if !clause.Operator.IsUnknown() && !slices.Contains(operators, clause.Operator.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					clausePath.AtName("operator"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("The %q operator is not supported by the %q variable. Supported operators: %s.", clause.Operator.ValueString(), clause.Variable.ValueString(), strings.Join(operators, ", ")),
+				)
+			}
+		}
+	}
+}
+
+// monitorConfigValues returns the configured values of a monitor by attribute name.
+func monitorConfigValues(config tfsdk.Config, diags *diag.Diagnostics) (map[string]tftypes.Value, bool) {
+	var values map[string]tftypes.Value
+	if err := config.Raw.As(&values); err != nil {
+		diags.AddError(
+			"Unable to Read Configuration",
+			fmt.Sprintf("Unable to read the monitor configuration: %s", err),
+		)
+		return nil, false
+	}
+	return values, true
+}
+
+// checkMonitorAttributes reports the required attributes that are not set,
+// and the candidate attributes that are set but neither required nor
+// optional. The subject names the monitors the attributes apply to.
+func checkMonitorAttributes(diags *diag.Diagnostics, values map[string]tftypes.Value, candidates []string, attributes monitorTypeAttributes, subject string) {
+	// Unknown values may still resolve to null, so only a known null is missing
+	for _, name := range attributes.required {
+		if value, ok := values[name]; ok && value.IsKnown() && value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing Required Attribute",
+				fmt.Sprintf("The %q attribute is required for %s.", name, subject),
+			)
+		}
+	}
+
+	candidates = slices.Clone(candidates)
+	sort.Strings(candidates)
+
+	supported := slices.Concat(attributes.required, attributes.optional)
+	for _, name := range candidates {
+		if values[name].IsNull() || slices.Contains(supported, name) {
+			continue
+		}
+
+		detail := fmt.Sprintf("The %q attribute is not supported by %s and would be ignored.", name, subject)
+		if len(supported) > 0 {
+			detail += " Supported attributes: " + strings.Join(supported, ", ") + "."
+		}
+		diags.AddAttributeError(path.Root(name), "Invalid Attribute Combination", detail)
+	}
+}
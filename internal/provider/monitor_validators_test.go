@@ -0,0 +1,376 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMonitorTypeAttributesValidator(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&MonitorResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// Every table entry must refer to an attribute of the monitor schema
+	for monitorType, attributes := range monitorTypeAttributeTable {
+		for _, name := range append(append([]string{}, attributes.required...), attributes.optional...) {
+			if _, ok := objType.AttributeTypes[name]; !ok {
+				t.Errorf("%s: unknown attribute %q", monitorType, name)
+			}
+		}
+	}
+
+	tests := map[string]struct {
+		values     map[string]tftypes.Value
+		wantErrors []path.Path
+	}{
+		"valid": {
+			values: map[string]tftypes.Value{
+				"type":     tftypes.NewValue(tftypes.String, "port"),
+				"name":     tftypes.NewValue(tftypes.String, "test"),
+				"hostname": tftypes.NewValue(tftypes.String, "example.com"),
+				"port":     tftypes.NewValue(tftypes.Number, 443),
+			},
+		},
+		"missing required": {
+			values: map[string]tftypes.Value{
+				"type":     tftypes.NewValue(tftypes.String, "port"),
+				"hostname": tftypes.NewValue(tftypes.String, "example.com"),
+			},
+			wantErrors: []path.Path{path.Root("port")},
+		},
+		"unknown required": {
+			values: map[string]tftypes.Value{
+				"type":     tftypes.NewValue(tftypes.String, "port"),
+				"hostname": tftypes.NewValue(tftypes.String, "example.com"),
+				"port":     tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			},
+		},
+		"forbidden": {
+			values: map[string]tftypes.Value{
+				"type":     tftypes.NewValue(tftypes.String, "ping"),
+				"hostname": tftypes.NewValue(tftypes.String, "example.com"),
+				"url":      tftypes.NewValue(tftypes.String, "https://example.com"),
+				"keyword":  tftypes.NewValue(tftypes.String, "ok"),
+			},
+			wantErrors: []path.Path{path.Root("keyword"), path.Root("url")},
+		},
+		"raw_config on modeled type": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "manual"),
+				"raw_config": tftypes.NewValue(tftypes.String, "{}"),
+			},
+			wantErrors: []path.Path{path.Root("raw_config")},
+		},
+		"unmodeled type": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "dns"),
+				"raw_config": tftypes.NewValue(tftypes.String, "{}"),
+				"hostname":   tftypes.NewValue(tftypes.String, "example.com"),
+			},
+			wantErrors: []path.Path{path.Root("hostname")},
+		},
+		"raw_config with reserved key": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "dns"),
+				"raw_config": tftypes.NewValue(tftypes.String, `{"hostname": "example.com", "description": "managed"}`),
+			},
+			wantErrors: []path.Path{path.Root("raw_config")},
+		},
+		"unknown type": {
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"url":  tftypes.NewValue(tftypes.String, "https://example.com"),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testMonitorConfigValidator(t, monitorTypeAttributesValidator{}, tt.values, tt.wantErrors)
+		})
+	}
+}
+
+func TestMonitorAuthMethodAttributesValidator(t *testing.T) {
+	tests := map[string]struct {
+		// monitorType is the type of a per-type monitor resource
+		monitorType string
+		values      map[string]tftypes.Value
+		wantErrors  []path.Path
+	}{
+		"no auth": {
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "http"),
+			},
+		},
+		"credentials without auth_method": {
+			values: map[string]tftypes.Value{
+				"type":            tftypes.NewValue(tftypes.String, "http"),
+				"basic_auth_user": tftypes.NewValue(tftypes.String, "user"),
+			},
+			wantErrors: []path.Path{path.Root("basic_auth_user")},
+		},
+		"mtls": {
+			values: map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "keyword"),
+				"auth_method": tftypes.NewValue(tftypes.String, "mtls"),
+				"tls_cert":    tftypes.NewValue(tftypes.String, "cert"),
+				"tls_key":     tftypes.NewValue(tftypes.String, "key"),
+				"tls_ca":      tftypes.NewValue(tftypes.String, "ca"),
+			},
+		},
+		"mtls missing key": {
+			values: map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "http"),
+				"auth_method": tftypes.NewValue(tftypes.String, "mtls"),
+				"tls_cert":    tftypes.NewValue(tftypes.String, "cert"),
+			},
+			wantErrors: []path.Path{path.Root("tls_key")},
+		},
+		"oauth2-cc with basic credentials": {
+			values: map[string]tftypes.Value{
+				"type":                tftypes.NewValue(tftypes.String, "http"),
+				"auth_method":         tftypes.NewValue(tftypes.String, "oauth2-cc"),
+				"oauth_client_id":     tftypes.NewValue(tftypes.String, "id"),
+				"oauth_client_secret": tftypes.NewValue(tftypes.String, "secret"),
+				"oauth_token_url":     tftypes.NewValue(tftypes.String, "https://example.com/token"),
+				"basic_auth_pass":     tftypes.NewValue(tftypes.String, "pass"),
+			},
+			wantErrors: []path.Path{path.Root("basic_auth_pass")},
+		},
+		"ntlm": {
+			values: map[string]tftypes.Value{
+				"type":            tftypes.NewValue(tftypes.String, "http"),
+				"auth_method":     tftypes.NewValue(tftypes.String, "ntlm"),
+				"basic_auth_user": tftypes.NewValue(tftypes.String, "user"),
+				"basic_auth_pass": tftypes.NewValue(tftypes.String, "pass"),
+				"auth_domain":     tftypes.NewValue(tftypes.String, "CORP"),
+			},
+		},
+		"unknown auth_method": {
+			values: map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "http"),
+				"auth_method": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"tls_cert":    tftypes.NewValue(tftypes.String, "cert"),
+			},
+		},
+		"per-type resource": {
+			monitorType: "keyword",
+			values: map[string]tftypes.Value{
+				"auth_method": tftypes.NewValue(tftypes.String, "mtls"),
+				"tls_cert":    tftypes.NewValue(tftypes.String, "cert"),
+			},
+			wantErrors: []path.Path{path.Root("tls_key")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testMonitorConfigValidator(t, monitorAuthMethodAttributesValidator{monitorType: tt.monitorType}, tt.values, tt.wantErrors)
+		})
+	}
+}
+
+func TestMonitorPingTimeoutValidator(t *testing.T) {
+	tests := map[string]struct {
+		// monitorType is the type of a per-type monitor resource
+		monitorType string
+		values      map[string]tftypes.Value
+		wantErrors  []path.Path
+	}{
+		"ping within bounds": {
+			values: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "ping"),
+				"timeout": tftypes.NewValue(tftypes.Number, 300),
+			},
+		},
+		"ping above bounds": {
+			values: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "ping"),
+				"timeout": tftypes.NewValue(tftypes.Number, 301),
+			},
+			wantErrors: []path.Path{path.Root("timeout")},
+		},
+		"other type": {
+			values: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "http"),
+				"timeout": tftypes.NewValue(tftypes.Number, 600),
+			},
+		},
+		"per-type resource below bounds": {
+			monitorType: "ping",
+			values: map[string]tftypes.Value{
+				"timeout": tftypes.NewValue(tftypes.Number, 0.5),
+			},
+			wantErrors: []path.Path{path.Root("timeout")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testMonitorConfigValidator(t, monitorPingTimeoutValidator{monitorType: tt.monitorType}, tt.values, tt.wantErrors)
+		})
+	}
+}
+
+func TestMonitorHeadersValidator(t *testing.T) {
+	headers := func(values map[string]string) tftypes.Value {
+		elems := make(map[string]tftypes.Value, len(values))
+		for name, value := range values {
+			elems[name] = tftypes.NewValue(tftypes.String, value)
+		}
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems)
+	}
+
+	testMonitorConfigValidator(t, monitorHeadersValidator{}, map[string]tftypes.Value{
+		"type":              tftypes.NewValue(tftypes.String, "http"),
+		"request_headers":   headers(map[string]string{"Accept": "application/json"}),
+		"sensitive_headers": headers(map[string]string{"Authorization": "Bearer token"}),
+	}, nil)
+
+	testMonitorConfigValidator(t, monitorHeadersValidator{}, map[string]tftypes.Value{
+		"type":              tftypes.NewValue(tftypes.String, "http"),
+		"request_headers":   headers(map[string]string{"Authorization": "Basic a"}),
+		"sensitive_headers": headers(map[string]string{"authorization": "Bearer token"}),
+	}, []path.Path{path.Root("sensitive_headers").AtMapKey("authorization")})
+}
+
+func TestMonitorConditionsValidator(t *testing.T) {
+	clauseType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"and_or": tftypes.String, "variable": tftypes.String, "operator": tftypes.String, "value": tftypes.String,
+	}}
+	groupType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"and_or": tftypes.String, "clauses": tftypes.List{ElementType: clauseType},
+	}}
+	conditions := func(clauses ...[3]string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(clauses))
+		for _, clause := range clauses {
+			elems = append(elems, tftypes.NewValue(clauseType, map[string]tftypes.Value{
+				"and_or":   tftypes.NewValue(tftypes.String, "and"),
+				"variable": tftypes.NewValue(tftypes.String, clause[0]),
+				"operator": tftypes.NewValue(tftypes.String, clause[1]),
+				"value":    tftypes.NewValue(tftypes.String, clause[2]),
+			}))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: groupType}, []tftypes.Value{
+			tftypes.NewValue(groupType, map[string]tftypes.Value{
+				"and_or":  tftypes.NewValue(tftypes.String, "and"),
+				"clauses": tftypes.NewValue(tftypes.List{ElementType: clauseType}, elems),
+			}),
+		})
+	}
+	clausePath := path.Root("conditions").AtListIndex(0).AtName("clauses")
+
+	tests := map[string]struct {
+		// monitorType is the type of a per-type monitor resource
+		monitorType string
+		values      map[string]tftypes.Value
+		wantErrors  []path.Path
+	}{
+		"valid": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "dns"),
+				"conditions": conditions([3]string{"record", "contains", "10.0."}, [3]string{"record", "!=", "10.0.0.1"}),
+			},
+		},
+		"unknown variable": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "dns"),
+				"conditions": conditions([3]string{"record", "contains", "10.0."}, [3]string{"ttl", ">", "60"}),
+			},
+			wantErrors: []path.Path{clausePath.AtListIndex(1).AtName("variable")},
+		},
+		"unsupported operator": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "dns"),
+				"conditions": conditions([3]string{"record", ">", "10"}),
+			},
+			wantErrors: []path.Path{clausePath.AtListIndex(0).AtName("operator")},
+		},
+		"type without conditions": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "docker"),
+				"conditions": conditions([3]string{"record", "==", "a"}),
+			},
+			wantErrors: []path.Path{path.Root("conditions")},
+		},
+		"modeled type": {
+			values: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "http"),
+				"conditions": conditions([3]string{"record", "==", "a"}),
+			},
+		},
+		"per-type resource": {
+			monitorType: "dns",
+			values: map[string]tftypes.Value{
+				"conditions": conditions([3]string{"record", ">", "10"}),
+			},
+			wantErrors: []path.Path{clausePath.AtListIndex(0).AtName("operator")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testMonitorConfigValidator(t, monitorConditionsValidator{monitorType: tt.monitorType}, tt.values, tt.wantErrors)
+		})
+	}
+}
+
+// testMonitorConfigValidator runs a config validator of uptimekuma_monitor on
+// a configuration with the given values, all other attributes null, and
+// checks the paths of the reported errors.
+func testMonitorConfigValidator(t *testing.T, v resource.ConfigValidator, values map[string]tftypes.Value, wantErrors []path.Path) {
+	t.Helper()
+	ctx := context.Background()
+
+	schema, objType := testMonitorSchema(ctx)
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Schema: schema,
+			Raw:    testMonitorObject(objType, values),
+		},
+	}
+	resp := &resource.ValidateConfigResponse{}
+	v.ValidateResource(ctx, req, resp)
+
+	if got := resp.Diagnostics.ErrorsCount(); got != len(wantErrors) {
+		t.Fatalf("expected %d errors, got %d: %v", len(wantErrors), got, resp.Diagnostics)
+	}
+	for i, diag := range resp.Diagnostics.Errors() {
+		withPath, ok := diag.(interface{ Path() path.Path })
+		if !ok || !withPath.Path().Equal(wantErrors[i]) {
+			t.Errorf("error %d: expected path %s, got %v", i, wantErrors[i], diag)
+		}
+	}
+}
+
+// testMonitorSchema returns the schema of uptimekuma_monitor and its object type.
+func testMonitorSchema(ctx context.Context) (schema.Schema, tftypes.Object) {
+	schemaResp := &resource.SchemaResponse{}
+	(&MonitorResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema, schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+}
+
+// testMonitorObject returns a monitor object with the given attribute values
+// and all other attributes null.
+func testMonitorObject(objType tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range values {
+		attrs[name] = value
+	}
+	return tftypes.NewValue(objType, attrs)
+}
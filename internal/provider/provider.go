@@ -5,14 +5,22 @@ package provider
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
 )
@@ -32,10 +40,32 @@ type UptimeKumaProvider struct {
 
 // UptimeKumaProviderModel describes the provider data model.
 type UptimeKumaProviderModel struct {
-	BaseURL       types.String `tfsdk:"base_url"`
-	Username      types.String `tfsdk:"username"`
-	Password      types.String `tfsdk:"password"`
-	InsecureHTTPS types.Bool   `tfsdk:"insecure_https"`
+	BaseURL         types.String `tfsdk:"base_url"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	InsecureHTTPS   types.Bool   `tfsdk:"insecure_https"`
+	AutoCreateTags  types.Bool   `tfsdk:"auto_create_tags"`
+	DefaultTags     types.Set    `tfsdk:"default_tags"`
+	MonitorDefaults types.Object `tfsdk:"monitor_defaults"`
+}
+
+// providerMonitorDefaultsModel describes the monitor_defaults block.
+type providerMonitorDefaultsModel struct {
+	Interval            types.Int64 `tfsdk:"interval"`
+	RetryInterval       types.Int64 `tfsdk:"retry_interval"`
+	ResendInterval      types.Int64 `tfsdk:"resend_interval"`
+	MaxRetries          types.Int64 `tfsdk:"max_retries"`
+	UpsideDown          types.Bool  `tfsdk:"upside_down"`
+	NotificationIDList  types.List  `tfsdk:"notification_id_list"`
+	AcceptedStatusCodes types.Set   `tfsdk:"accepted_status_codes"`
+}
+
+// providerDefaultTagModel describes an entry of the default_tags attribute.
+type providerDefaultTagModel struct {
+	TagID types.Int64  `tfsdk:"tag_id"`
+	Name  types.String `tfsdk:"name"`
+	Color types.String `tfsdk:"color"`
+	Value types.String `tfsdk:"value"`
 }
 
 func (p *UptimeKumaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -64,6 +94,88 @@ func (p *UptimeKumaProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				MarkdownDescription: "Skip TLS certificate verification",
 				Optional:            true,
 			},
+			"auto_create_tags": schema.BoolAttribute{
+				MarkdownDescription: "Create the tags that monitors reference by `name` when they do not exist. Defaults to false, which makes a missing tag an error.",
+				Optional:            true,
+			},
+			"default_tags": schema.SetNestedAttribute{
+				MarkdownDescription: "Tags added to every monitor, shown in its `tags_all` attribute. A monitor that sets the same tag in `tags` overrides the default tag.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_id": schema.Int64Attribute{
+							MarkdownDescription: "Tag ID. Either `tag_id` or `name` must be set.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("name")),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Tag name, resolved to the tag ID. A tag that does not exist when applying is an error, unless `auto_create_tags` is set.",
+							Optional:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "Color of the tag created for `name` when it does not exist, in hex format",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^#([0-9A-Fa-f]{3}){1,2}$`),
+									"must be a valid hex color code (e.g., #FFF or #FFFFFF)",
+								),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("name")),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value for the tag",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"monitor_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Defaults for the attributes of every monitor that the monitor does not set. The plan of each monitor shows the effective values.",
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Check interval in seconds (20 to 2073600, i.e. 24 days)",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(minMonitorInterval, maxMonitorInterval),
+						},
+					},
+					"retry_interval": schema.Int64Attribute{
+						MarkdownDescription: "Retry interval in seconds",
+						Optional:            true,
+					},
+					"resend_interval": schema.Int64Attribute{
+						MarkdownDescription: "Notification resend interval in seconds",
+						Optional:            true,
+					},
+					"max_retries": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of retries",
+						Optional:            true,
+					},
+					"upside_down": schema.BoolAttribute{
+						MarkdownDescription: "Invert status (treat DOWN as UP and vice versa)",
+						Optional:            true,
+					},
+					"notification_id_list": schema.ListAttribute{
+						ElementType:         types.Int64Type,
+						MarkdownDescription: "List of notification IDs to trigger when monitor status changes",
+						Optional:            true,
+					},
+					"accepted_status_codes": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Accepted HTTP status codes of the monitor types that check a status code, as single codes or ranges (e.g., [\"200-299\", \"304\"])",
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(acceptedStatusCodeValidator()),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -104,15 +216,109 @@ func (p *UptimeKumaProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	// The connection pool shares clients between provider instances, so the
+	// provider settings are set on a copy
+	providerClient := *apiClient
+	providerClient.AutoCreateTags = data.AutoCreateTags.ValueBool()
+
+	if data.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown Default Tag",
+			"The tags of default_tags must be known when the provider is configured.",
+		)
+		return
+	}
+	if !data.DefaultTags.IsNull() {
+		var defaultTags []providerDefaultTagModel
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, t := range defaultTags {
+			if t.TagID.IsUnknown() || t.Name.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("default_tags"),
+					"Unknown Default Tag",
+					"The tags of default_tags must be known when the provider is configured. "+
+						"Reference a tag created in the same configuration by name instead of by ID.",
+				)
+				return
+			}
+			providerClient.DefaultTags = append(providerClient.DefaultTags, client.MonitorTag{
+				TagID: t.TagID.ValueInt64(),
+				Name:  t.Name.ValueString(),
+				Color: t.Color.ValueString(),
+				Value: t.Value.ValueString(),
+			})
+		}
+	}
+
+	if !data.MonitorDefaults.IsNull() {
+		defaults, diags := providerMonitorDefaults(ctx, data.MonitorDefaults)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		providerClient.MonitorDefaults = defaults
+	}
+
+	resp.DataSourceData = &providerClient
+	resp.ResourceData = &providerClient
+}
+
+// providerMonitorDefaults converts the monitor_defaults block into the
+// client monitor defaults.
+func providerMonitorDefaults(ctx context.Context, value types.Object) (client.MonitorDefaults, diag.Diagnostics) {
+	var defaults client.MonitorDefaults
+	var data providerMonitorDefaultsModel
+	var diags diag.Diagnostics
+
+	unknown := value.IsUnknown()
+	for _, attr := range value.Attributes() {
+		unknown = unknown || attr.IsUnknown()
+	}
+	if unknown {
+		diags.AddAttributeError(
+			path.Root("monitor_defaults"),
+			"Unknown Monitor Default",
+			"The monitor_defaults must be known when the provider is configured.",
+		)
+		return defaults, diags
+	}
+
+	diags.Append(value.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return defaults, diags
+	}
+
+	defaults.Interval = data.Interval.ValueInt64Pointer()
+	defaults.RetryInterval = data.RetryInterval.ValueInt64Pointer()
+	defaults.ResendInterval = data.ResendInterval.ValueInt64Pointer()
+	defaults.MaxRetries = data.MaxRetries.ValueInt64Pointer()
+	defaults.UpsideDown = data.UpsideDown.ValueBoolPointer()
+	if !data.NotificationIDList.IsNull() {
+		diags.Append(data.NotificationIDList.ElementsAs(ctx, &defaults.NotificationIDs, false)...)
+	}
+	if !data.AcceptedStatusCodes.IsNull() {
+		diags.Append(data.AcceptedStatusCodes.ElementsAs(ctx, &defaults.AcceptedStatusCodes, false)...)
+	}
+
+	return defaults, diags
 }
 
 func (p *UptimeKumaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewMonitorResource,
+		NewMonitorHTTPResource,
+		NewMonitorKeywordResource,
+		NewMonitorPingResource,
+		NewMonitorPortResource,
+		NewMonitorDNSResource,
 		NewStatusPageResource,
 		NewTagResource,
+		NewRemoteBrowserResource,
+		NewProxyResource,
 	}
 }
 
@@ -4,11 +4,15 @@
 package provider
 
 import (
+	"context"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -53,3 +57,55 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 }
+
+func TestProviderMonitorDefaults(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{
+		"interval":              types.Int64Type,
+		"retry_interval":        types.Int64Type,
+		"resend_interval":       types.Int64Type,
+		"max_retries":           types.Int64Type,
+		"upside_down":           types.BoolType,
+		"notification_id_list":  types.ListType{ElemType: types.Int64Type},
+		"accepted_status_codes": types.SetType{ElemType: types.StringType},
+	}
+
+	value := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"interval":              types.Int64Value(60),
+		"retry_interval":        types.Int64Value(30),
+		"resend_interval":       types.Int64Null(),
+		"max_retries":           types.Int64Value(3),
+		"upside_down":           types.BoolNull(),
+		"notification_id_list":  types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(4)}),
+		"accepted_status_codes": types.SetNull(types.StringType),
+	})
+
+	got, diags := providerMonitorDefaults(ctx, value)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	interval, retryInterval, maxRetries := int64(60), int64(30), int64(3)
+	want := client.MonitorDefaults{
+		Interval:        &interval,
+		RetryInterval:   &retryInterval,
+		MaxRetries:      &maxRetries,
+		NotificationIDs: []int64{4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	unknown := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"interval":              types.Int64Unknown(),
+		"retry_interval":        types.Int64Null(),
+		"resend_interval":       types.Int64Null(),
+		"max_retries":           types.Int64Null(),
+		"upside_down":           types.BoolNull(),
+		"notification_id_list":  types.ListNull(types.Int64Type),
+		"accepted_status_codes": types.SetNull(types.StringType),
+	})
+	if _, diags := providerMonitorDefaults(ctx, unknown); !diags.HasError() {
+		t.Error("expected an error for unknown monitor defaults")
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumaproxy "github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProxyResource{}
+var _ resource.ResourceWithImportState = &ProxyResource{}
+var _ resource.ResourceWithValidateConfig = &ProxyResource{}
+
+func NewProxyResource() resource.Resource {
+	return &ProxyResource{}
+}
+
+// ProxyResource defines the resource implementation.
+type ProxyResource struct {
+	client *client.Client
+}
+
+// ProxyResourceModel describes the resource data model.
+type ProxyResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Host          types.String `tfsdk:"host"`
+	Port          types.Int64  `tfsdk:"port"`
+	Auth          types.Bool   `tfsdk:"auth"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	Default       types.Bool   `tfsdk:"default"`
+	ApplyExisting types.Bool   `tfsdk:"apply_existing"`
+}
+
+func (r *ProxyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy"
+}
+
+func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uptime Kuma Proxy resource. Proxies route the requests of `http` and `keyword` monitors, selected with the `proxy_id` attribute of `uptimekuma_monitor`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Proxy identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Proxy protocol (http, https, socks4, socks5, socks5h)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "https", "socks4", "socks5", "socks5h"),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Proxy hostname or IP address",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Proxy port",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"auth": schema.BoolAttribute{
+				MarkdownDescription: "Whether the proxy requires authentication. Requires `username` and `password`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Proxy username",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Proxy password",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether new monitors use this proxy by default",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to apply the proxy to all existing monitors when it is created or updated. Not read back from Uptime Kuma.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProxyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProxyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.Auth.ValueBool() {
+		return
+	}
+
+	for name, value := range map[string]types.String{"username": data.Username, "password": data.Password} {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("The %q attribute is required when auth is true.", name),
+			)
+		}
+	}
+}
+
+func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the proxy
+	id, err := r.client.Kuma.CreateProxy(ctx, data.proxyConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create proxy: %s", err))
+		return
+	}
+
+	// Update Terraform state
+	data.ID = types.Int64Value(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proxyID := data.ID.ValueInt64()
+
+	// Read the proxy from the API
+	proxy, err := r.client.Kuma.GetProxy(ctx, proxyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read proxy %d: %s", proxyID, err),
+		)
+		return
+	}
+
+	if proxy.ID == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64Value(proxy.ID)
+	data.Protocol = types.StringValue(proxy.Protocol)
+	data.Host = types.StringValue(proxy.Host)
+	data.Port = types.Int64Value(int64(proxy.Port))
+	data.Auth = types.BoolValue(proxy.Auth)
+	data.Username = stringValueOrNull(proxy.Username)
+	// Keep the password from state when the server does not return it
+	if proxy.Password != "" {
+		data.Password = types.StringValue(proxy.Password)
+	}
+	data.Default = types.BoolValue(proxy.Default)
+	// apply_existing is an action, not a property of the proxy
+	if data.ApplyExisting.IsNull() {
+		data.ApplyExisting = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the proxy
+	if err := r.client.Kuma.UpdateProxy(ctx, data.proxyConfig()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update proxy %d: %s", data.ID.ValueInt64(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proxyID := data.ID.ValueInt64()
+
+	// Delete the proxy
+	if err := r.client.Kuma.DeleteProxy(ctx, proxyID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete proxy %d: %s", proxyID, err))
+		return
+	}
+}
+
+func (r *ProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Proxy ID",
+			fmt.Sprintf("Proxy ID must be a number, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Set the ID in the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// proxyConfig converts the model into the library proxy save request.
+func (m ProxyResourceModel) proxyConfig() kumaproxy.Config {
+	return kumaproxy.Config{
+		Proxy: kumaproxy.Proxy{
+			ID:       m.ID.ValueInt64(),
+			Protocol: m.Protocol.ValueString(),
+			Host:     m.Host.ValueString(),
+			Port:     int(m.Port.ValueInt64()),
+			Auth:     m.Auth.ValueBool(),
+			Username: m.Username.ValueString(),
+			Password: m.Password.ValueString(),
+			Active:   true,
+			Default:  m.Default.ValueBool(),
+		},
+		ApplyExisting: m.ApplyExisting.ValueBool(),
+	}
+}
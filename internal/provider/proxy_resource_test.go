@@ -0,0 +1,79 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProxyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// auth requires credentials
+			{
+				Config:      testAccProxyResourceConfig("http", 3128, "auth = true"),
+				ExpectError: regexp.MustCompile(`The "username" attribute is required when auth is true`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProxyResourceConfig("http", 3128, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("uptimekuma_proxy.test", tfjsonpath.New("protocol"), knownvalue.StringExact("http")),
+					statecheck.ExpectKnownValue("uptimekuma_proxy.test", tfjsonpath.New("port"), knownvalue.Int64Exact(3128)),
+					statecheck.ExpectKnownValue("uptimekuma_proxy.test", tfjsonpath.New("auth"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("uptimekuma_proxy.test", tfjsonpath.New("default"), knownvalue.Bool(false)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_proxy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProxyResourceConfig("socks5", 1080, `
+  auth     = true
+  username = "proxy-user"
+  password = "proxy-pass"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("uptimekuma_proxy.test", tfjsonpath.New("protocol"), knownvalue.StringExact("socks5")),
+					statecheck.ExpectKnownValue("uptimekuma_proxy.test", tfjsonpath.New("username"), knownvalue.StringExact("proxy-user")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProxyResourceConfig(protocol string, port int, extra string) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_proxy" "test" {
+  protocol = %[4]q
+  host     = "proxy.example.com"
+  port     = %[5]d
+%[6]s
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		protocol, port, extra)
+}
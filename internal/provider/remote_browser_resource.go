@@ -0,0 +1,213 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kumaremotebrowser "github.com/breml/go-uptime-kuma-client/remotebrowser"
+	"github.com/ehealth-co-id/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RemoteBrowserResource{}
+var _ resource.ResourceWithImportState = &RemoteBrowserResource{}
+
+func NewRemoteBrowserResource() resource.Resource {
+	return &RemoteBrowserResource{}
+}
+
+// RemoteBrowserResource defines the resource implementation.
+type RemoteBrowserResource struct {
+	client *client.Client
+}
+
+// RemoteBrowserResourceModel describes the resource data model.
+type RemoteBrowserResourceModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
+}
+
+func (r *RemoteBrowserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_browser"
+}
+
+func (r *RemoteBrowserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uptime Kuma Remote Browser resource. Remote browsers are Chromium instances reachable over the DevTools protocol that `real-browser` monitors can use instead of the browser bundled with Uptime Kuma.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Remote browser identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Remote browser name",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "WebSocket URL of the remote browser (e.g., ws://chrome:3000/chrome/playwright?token=secret)",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *RemoteBrowserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RemoteBrowserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteBrowser := kumaremotebrowser.RemoteBrowser{
+		Name: data.Name.ValueString(),
+		URL:  data.URL.ValueString(),
+	}
+
+	// Create the remote browser
+	id, err := r.client.Kuma.CreateRemoteBrowser(ctx, remoteBrowser)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create remote browser: %s", err))
+		return
+	}
+
+	// Update Terraform state
+	data.ID = types.Int64Value(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteBrowserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteBrowserID := data.ID.ValueInt64()
+
+	// Read the remote browser from the API
+	remoteBrowser, err := r.client.Kuma.GetRemoteBrowser(ctx, remoteBrowserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read remote browser %d: %s", remoteBrowserID, err),
+		)
+		return
+	}
+
+	if remoteBrowser.ID == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64Value(remoteBrowser.ID)
+	data.Name = types.StringValue(remoteBrowser.Name)
+	data.URL = types.StringValue(remoteBrowser.URL)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteBrowserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteBrowser := kumaremotebrowser.RemoteBrowser{
+		ID:   data.ID.ValueInt64(),
+		Name: data.Name.ValueString(),
+		URL:  data.URL.ValueString(),
+	}
+
+	// Update the remote browser
+	if err := r.client.Kuma.UpdateRemoteBrowser(ctx, remoteBrowser); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update remote browser %d: %s", remoteBrowser.ID, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteBrowserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteBrowserID := data.ID.ValueInt64()
+
+	// Delete the remote browser
+	if err := r.client.Kuma.DeleteRemoteBrowser(ctx, remoteBrowserID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete remote browser %d: %s", remoteBrowserID, err))
+		return
+	}
+}
+
+func (r *RemoteBrowserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Remote Browser ID",
+			fmt.Sprintf("Remote browser ID must be a number, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Set the ID in the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
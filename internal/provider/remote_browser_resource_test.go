@@ -0,0 +1,82 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRemoteBrowserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRemoteBrowserResourceConfig("chrome-1", "ws://chrome:3000/chrome/playwright"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("chrome-1"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact("ws://chrome:3000/chrome/playwright"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "uptimekuma_remote_browser.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRemoteBrowserResourceConfig("chrome-2", "ws://chrome:3000/chrome/playwright?token=secret"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("chrome-2"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact("ws://chrome:3000/chrome/playwright?token=secret"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRemoteBrowserResourceConfig(name, url string) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  base_url = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "uptimekuma_remote_browser" "test" {
+  name = %[4]q
+  url  = %[5]q
+}
+`,
+		os.Getenv("UPTIMEKUMA_BASE_URL"),
+		os.Getenv("UPTIMEKUMA_USERNAME"),
+		os.Getenv("UPTIMEKUMA_PASSWORD"),
+		name, url)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the custom type satisfies framework interfaces.
+var _ basetypes.StringTypable = WhitespaceInsensitiveStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = WhitespaceInsensitiveString{}
+
+// WhitespaceInsensitiveStringType is a string type whose values are considered
+// equal when they only differ in whitespace. It is used for multi-line text such
+// as protobuf definitions, which are usually loaded with file() and may come back
+// from Uptime Kuma with different indentation or without the trailing newline.
+type WhitespaceInsensitiveStringType struct {
+	basetypes.StringType
+}
+
+func (t WhitespaceInsensitiveStringType) Equal(o attr.Type) bool {
+	other, ok := o.(WhitespaceInsensitiveStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t WhitespaceInsensitiveStringType) String() string {
+	return "WhitespaceInsensitiveStringType"
+}
+
+func (t WhitespaceInsensitiveStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return WhitespaceInsensitiveString{StringValue: in}, nil
+}
+
+func (t WhitespaceInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t WhitespaceInsensitiveStringType) ValueType(ctx context.Context) attr.Value {
+	return WhitespaceInsensitiveString{}
+}
+
+// WhitespaceInsensitiveString is the value type of WhitespaceInsensitiveStringType.
+type WhitespaceInsensitiveString struct {
+	basetypes.StringValue
+}
+
+// NewWhitespaceInsensitiveStringValue returns a known WhitespaceInsensitiveString.
+func NewWhitespaceInsensitiveStringValue(value string) WhitespaceInsensitiveString {
+	return WhitespaceInsensitiveString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewWhitespaceInsensitiveStringNull returns a null WhitespaceInsensitiveString.
+func NewWhitespaceInsensitiveStringNull() WhitespaceInsensitiveString {
+	return WhitespaceInsensitiveString{StringValue: basetypes.NewStringNull()}
+}
+
+func (v WhitespaceInsensitiveString) Equal(o attr.Value) bool {
+	other, ok := o.(WhitespaceInsensitiveString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v WhitespaceInsensitiveString) Type(ctx context.Context) attr.Type {
+	return WhitespaceInsensitiveStringType{}
+}
+
+// StringSemanticEquals reports whether both values are equal once every run of
+// whitespace is collapsed into a single space and the ends are trimmed.
+func (v WhitespaceInsensitiveString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(WhitespaceInsensitiveString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalizeWhitespace(v.ValueString()) == normalizeWhitespace(newValue.ValueString()), diags
+}
+
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
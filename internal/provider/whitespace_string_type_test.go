@@ -0,0 +1,58 @@
+// Copyright (c) eHealth.co.id as PT Aksara Digital Indonesia
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWhitespaceInsensitiveStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		current  string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			current:  "syntax = \"proto3\";",
+			new:      "syntax = \"proto3\";",
+			expected: true,
+		},
+		"trailing newline": {
+			current:  "syntax = \"proto3\";\n",
+			new:      "syntax = \"proto3\";",
+			expected: true,
+		},
+		"indentation": {
+			current:  "service Health {\n  rpc Check (Req) returns (Res);\n}\n",
+			new:      "service Health {\n\trpc Check (Req) returns (Res);\n}",
+			expected: true,
+		},
+		"different content": {
+			current:  "service Health {}",
+			new:      "service Status {}",
+			expected: false,
+		},
+		"removed separator": {
+			current:  "int32 count = 1;",
+			new:      "int32count = 1;",
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := NewWhitespaceInsensitiveStringValue(tc.current).StringSemanticEquals(
+				context.Background(),
+				NewWhitespaceInsensitiveStringValue(tc.new),
+			)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}